@@ -6,14 +6,20 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/neokofg/go-pet-microservices/api-gateway/handlers"
 	"github.com/neokofg/go-pet-microservices/api-gateway/middleware"
+	"github.com/neokofg/go-pet-microservices/api-gateway/tracing"
 	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	recommendpb "github.com/neokofg/go-pet-microservices/recommendation-service/api/proto"
+	searchpb "github.com/neokofg/go-pet-microservices/search-service/api/proto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 )
@@ -22,26 +28,109 @@ func main() {
 	logger, _ := zap.NewProduction()
 	defer logger.Sync()
 
+	shutdownTracing, err := tracing.Init(context.Background(), "api-gateway")
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error("Failed to shutdown tracing", zap.Error(err))
+		}
+	}()
+
 	router := gin.New()
+
+	// Без доверенных прокси ClientIP берётся из адреса соединения, и клиент не может
+	// подменить его заголовком X-Forwarded-For, чтобы обойти ограничение частоты запросов
+	var trustedProxies []string
+	if proxies := os.Getenv("TRUSTED_PROXIES"); proxies != "" {
+		trustedProxies = strings.Split(proxies, ",")
+	}
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		logger.Fatal("Invalid TRUSTED_PROXIES", zap.Error(err))
+	}
+
+	rateLimitRules := []middleware.RateLimitRule{
+		{
+			Name:        "search",
+			Prefix:      "/api/v1/search",
+			Limit:       rateLimitFromEnv(logger, "RATE_LIMIT_SEARCH", middleware.RateLimit{Rate: 5, Burst: 10}),
+			APIKeyLimit: rateLimitFromEnv(logger, "RATE_LIMIT_SEARCH_API_KEY", middleware.RateLimit{Rate: 20, Burst: 40}),
+		},
+		{
+			Name:        "write",
+			Prefix:      "/api/",
+			Methods:     []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+			Limit:       rateLimitFromEnv(logger, "RATE_LIMIT_WRITE", middleware.RateLimit{Rate: 2, Burst: 5}),
+			APIKeyLimit: rateLimitFromEnv(logger, "RATE_LIMIT_WRITE_API_KEY", middleware.RateLimit{Rate: 20, Burst: 100}),
+		},
+		{
+			Name:        "read",
+			Prefix:      "/api/",
+			Limit:       rateLimitFromEnv(logger, "RATE_LIMIT_READ", middleware.RateLimit{Rate: 20, Burst: 40}),
+			APIKeyLimit: rateLimitFromEnv(logger, "RATE_LIMIT_READ_API_KEY", middleware.RateLimit{Rate: 100, Burst: 200}),
+		},
+	}
+
+	verifier := initTokenVerifier(logger)
+
+	catalogConn, err := initGRPCClient(os.Getenv("CATALOG_SERVICE_ADDR"))
+	if err != nil {
+		logger.Fatal("Failed to connect to catalog service", zap.Error(err))
+	}
+	defer catalogConn.Close()
+
+	catalogClient := proto.NewCatalogServiceClient(catalogConn)
+	apiKeyClient := proto.NewAPIKeyServiceClient(catalogConn)
+
+	apiKeyCacheTTL := 30 * time.Second
+	if value := os.Getenv("API_KEY_CACHE_TTL"); value != "" {
+		apiKeyCacheTTL, err = time.ParseDuration(value)
+		if err != nil || apiKeyCacheTTL <= 0 {
+			logger.Fatal("Invalid API_KEY_CACHE_TTL", zap.String("value", value))
+		}
+	}
+
+	// Проверки неизвестных ключей в каталоге ограничиваются по IP клиента до проверки ключа
+	apiKeyLookupLimit := rateLimitFromEnv(logger, "RATE_LIMIT_API_KEY_LOOKUP", middleware.RateLimit{Rate: 1, Burst: 10})
+	rateLimitStore := middleware.NewMemoryRateLimitStore()
+
 	router.Use(
 		gin.Recovery(),
+		middleware.TracingMiddleware("api-gateway"),
+		middleware.RequestIDMiddleware(),
 		middleware.CORSMiddleware(),
 		middleware.RequestLoggerMiddleware(logger),
 		middleware.PrometheusMiddleware(),
-		middleware.RateLimiterMiddleware(),
+		middleware.APIKeyMiddleware(apiKeyClient, logger, apiKeyCacheTTL, rateLimitStore, apiKeyLookupLimit),
+		middleware.RateLimiterMiddleware(rateLimitStore, logger, rateLimitRules...),
+		middleware.AuthMiddleware(verifier),
 	)
 
-	catalogConn, err := initGRPCClient(os.Getenv("CATALOG_SERVICE_ADDR"))
+	searchConn, err := initGRPCClient(os.Getenv("SEARCH_SERVICE_ADDR"))
 	if err != nil {
-		logger.Fatal("Failed to connect to catalog service", zap.Error(err))
+		logger.Fatal("Failed to connect to search service", zap.Error(err))
 	}
-	defer catalogConn.Close()
+	defer searchConn.Close()
 
-	catalogClient := proto.NewCatalogServiceClient(catalogConn)
+	searchClient := searchpb.NewSearchServiceClient(searchConn)
+
+	recommendConn, err := initGRPCClient(os.Getenv("RECOMMEND_SERVICE_ADDR"))
+	if err != nil {
+		logger.Fatal("Failed to connect to recommendation service", zap.Error(err))
+	}
+	defer recommendConn.Close()
+
+	recommendClient := recommendpb.NewRecommendationServiceClient(recommendConn)
 
 	app := handlers.NewApp(
 		logger,
 		catalogClient,
+		apiKeyClient,
+		searchClient,
+		recommendClient,
 	)
 
 	router.GET("/health", func(c *gin.Context) {
@@ -79,8 +168,61 @@ func main() {
 	logger.Info("Server exited properly")
 }
 
+// rateLimitFromEnv читает лимит в формате "<запросов в секунду>:<burst>", например "5:10"
+func rateLimitFromEnv(logger *zap.Logger, name string, def middleware.RateLimit) middleware.RateLimit {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+
+	rawRate, rawBurst, ok := strings.Cut(value, ":")
+	rate, rateErr := strconv.ParseFloat(rawRate, 64)
+	burst, burstErr := strconv.Atoi(rawBurst)
+	if !ok || rateErr != nil || burstErr != nil || rate <= 0 || burst <= 0 {
+		logger.Fatal("Invalid rate limit, expected <rate>:<burst>", zap.String("name", name), zap.String("value", value))
+	}
+	return middleware.RateLimit{Rate: rate, Burst: burst}
+}
+
+// initTokenVerifier настраивает проверку JWT. Нужен хотя бы один из JWT_HS256_SECRET
+// и JWT_JWKS (путь к файлу или URL набора ключей для RS256).
+func initTokenVerifier(logger *zap.Logger) *middleware.TokenVerifier {
+	secret := os.Getenv("JWT_HS256_SECRET")
+	jwksSource := os.Getenv("JWT_JWKS")
+	if secret == "" && jwksSource == "" {
+		logger.Fatal("JWT authentication is not configured, set JWT_HS256_SECRET or JWT_JWKS")
+	}
+
+	var jwks *middleware.JWKS
+	if jwksSource != "" {
+		var err error
+		jwks, err = middleware.NewJWKS(context.Background(), jwksSource)
+		if err != nil {
+			logger.Fatal("Failed to load JWKS", zap.Error(err))
+		}
+		go jwks.RefreshPeriodically(context.Background(), 10*time.Minute, func(err error) {
+			logger.Error("Failed to refresh JWKS", zap.Error(err))
+		})
+	}
+
+	return middleware.NewTokenVerifier([]byte(secret), jwks, os.Getenv("JWT_ISSUER"), os.Getenv("JWT_AUDIENCE"))
+}
+
+const maxGRPCMessageSize = 16 << 20
+
 func initGRPCClient(addr string) (*grpc.ClientConn, error) {
 	return grpc.NewClient(addr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		// Ответы пакетных RPC каталога могут быть больше стандартных 4 МБ
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxGRPCMessageSize)),
+		grpc.WithChainUnaryInterceptor(
+			middleware.RequestIDUnaryClientInterceptor(),
+			middleware.IdentityUnaryClientInterceptor(),
+		),
+		grpc.WithChainStreamInterceptor(
+			middleware.RequestIDStreamClientInterceptor(),
+			middleware.IdentityStreamClientInterceptor(),
+		),
 	)
 }
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/api-gateway/middleware"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (a *App) handleListAPIKeys(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	includeRevoked, err := strconv.ParseBool(c.DefaultQuery("include_revoked", "false"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.apiKeySvc.ListAPIKeys(ctx, &proto.ListAPIKeysRequest{
+		IncludeRevoked: includeRevoked,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+type IssueAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required"`
+	Scopes []string `json:"scopes"`
+	// TTLSeconds - срок действия ключа, 0 - бессрочный
+	TTLSeconds int64 `json:"ttl_seconds" binding:"min=0,max=31536000"`
+}
+
+// handleIssueAPIKey возвращает ключ целиком только в этом ответе, в каталоге хранится лишь его хэш
+func (a *App) handleIssueAPIKey(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	var req IssueAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	// Маршрут защищён RequireRoles, поэтому личность всегда есть
+	identity, _ := middleware.IdentityFromContext(c.Request.Context())
+
+	resp, err := a.apiKeySvc.IssueAPIKey(ctx, &proto.IssueAPIKeyRequest{
+		Name:       req.Name,
+		Scopes:     req.Scopes,
+		TtlSeconds: req.TTLSeconds,
+		CreatedBy:  identity.Subject,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleRevokeAPIKey(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	resp, err := a.apiKeySvc.RevokeAPIKey(ctx, &proto.RevokeAPIKeyRequest{
+		Id: id,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,305 @@
+package handlers
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/api-gateway/middleware"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// maxBatchBodySize ограничивает тело пакетного запроса, чтобы оно заведомо помещалось
+	// в одно gRPC сообщение к каталогу
+	maxBatchBodySize = 8 << 20
+	// batchTimeout больше обычных 5 секунд: пакет до 1000 элементов пишется одной транзакцией
+	batchTimeout = 30 * time.Second
+)
+
+// Пакетные действия над элементами в стиле AIP-136: POST /items:batchCreate и т.д.
+const (
+	actionBatchGet    = ":batchGet"
+	actionBatchCreate = ":batchCreate"
+	actionBatchUpdate = ":batchUpdate"
+	actionBatchDelete = ":batchDelete"
+	actionImport      = ":import"
+	actionExport      = ":export"
+)
+
+var batchModes = map[string]proto.BatchMode{
+	"":            proto.BatchMode_BATCH_MODE_ATOMIC,
+	"atomic":      proto.BatchMode_BATCH_MODE_ATOMIC,
+	"best_effort": proto.BatchMode_BATCH_MODE_BEST_EFFORT,
+}
+
+type BatchGetItemsRequest struct {
+	IDs []string `json:"ids" binding:"required,min=1,max=1000,dive,required"`
+}
+
+type BatchCreateItemsRequest struct {
+	Mode  string            `json:"mode" binding:"omitempty,oneof=atomic best_effort"`
+	Items []BatchCreateItem `json:"items" binding:"required,min=1,max=1000"`
+}
+
+// BatchCreateItem не проверяет поля в шлюзе: каталог вернёт ошибки по каждому элементу отдельно
+type BatchCreateItem struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	ImageUrl    string   `json:"imageUrl"`
+}
+
+type BatchUpdateItemsRequest struct {
+	Mode  string            `json:"mode" binding:"omitempty,oneof=atomic best_effort"`
+	Items []BatchUpdateItem `json:"items" binding:"required,min=1,max=1000,dive"`
+}
+
+// BatchUpdateItem обновляет только переданные поля, как PATCH. Version работает как If-Match.
+type BatchUpdateItem struct {
+	ID          string    `json:"id" binding:"required"`
+	Title       *string   `json:"title"`
+	Description *string   `json:"description"`
+	Tags        *[]string `json:"tags"`
+	ImageUrl    *string   `json:"imageUrl"`
+	Version     *int64    `json:"version"`
+}
+
+type BatchDeleteItemsRequest struct {
+	Mode  string            `json:"mode" binding:"omitempty,oneof=atomic best_effort"`
+	Items []BatchDeleteItem `json:"items" binding:"required,min=1,max=1000,dive"`
+}
+
+type BatchDeleteItem struct {
+	ID      string `json:"id" binding:"required"`
+	Version *int64 `json:"version"`
+}
+
+// BatchItemResult - результат одного элемента пакета. Error заполнен, если элемент не применён.
+type BatchItemResult struct {
+	Index  int32               `json:"index"`
+	ID     string              `json:"id,omitempty"`
+	Status int                 `json:"status"`
+	Item   *proto.Item         `json:"item,omitempty"`
+	Error  *middleware.Problem `json:"error,omitempty"`
+}
+
+type BatchItemsResponse struct {
+	Results   []BatchItemResult `json:"results"`
+	Succeeded int32             `json:"succeeded"`
+	Failed    int32             `json:"failed"`
+}
+
+// authorizeItemsAction проверяет права в зависимости от действия: batchGet доступен всем,
+// изменения и выгрузка всего каталога требуют тех же ролей, что и одиночные изменения
+func (a *App) authorizeItemsAction(canWrite gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Param("action") {
+		case actionBatchCreate, actionBatchUpdate, actionBatchDelete, actionImport, actionExport:
+			canWrite(c)
+		default:
+			c.Next()
+		}
+	}
+}
+
+// handleItemsAction обслуживает POST /items:<action>. gin не различает несколько
+// маршрутов с литеральным двоеточием, поэтому действие разбирается здесь.
+func (a *App) handleItemsAction(c *gin.Context) {
+	bodyLimit := int64(maxBatchBodySize)
+	if c.Param("action") == actionImport {
+		bodyLimit = maxImportBodySize
+	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, bodyLimit)
+
+	switch c.Param("action") {
+	case actionImport:
+		a.handleImportItems(c)
+	case actionBatchGet:
+		a.handleBatchGetItems(c)
+	case actionBatchCreate:
+		a.handleBatchCreateItems(c)
+	case actionBatchUpdate:
+		a.handleBatchUpdateItems(c)
+	case actionBatchDelete:
+		a.handleBatchDeleteItems(c)
+	default:
+		middleware.WriteProblem(c, middleware.NewProblem(c, http.StatusNotFound, codes.NotFound, "unknown action"))
+	}
+}
+
+func (a *App) handleBatchGetItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), batchTimeout)
+	defer cancel()
+
+	var req BatchGetItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.BatchGetItems(ctx, &proto.BatchGetItemsRequest{Ids: req.IDs})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleBatchCreateItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), batchTimeout)
+	defer cancel()
+
+	var req BatchCreateItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	items := make([]*proto.CreateItemRequest, len(req.Items))
+	for i, itm := range req.Items {
+		items[i] = &proto.CreateItemRequest{
+			Title:       itm.Title,
+			Description: itm.Description,
+			Tags:        itm.Tags,
+			ImageUrl:    itm.ImageUrl,
+		}
+	}
+
+	resp, err := a.catalogSvc.BatchCreateItems(ctx, &proto.BatchCreateItemsRequest{
+		Items: items,
+		Mode:  batchModes[req.Mode],
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	writeBatchResponse(c, resp)
+}
+
+func (a *App) handleBatchUpdateItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), batchTimeout)
+	defer cancel()
+
+	var req BatchUpdateItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	items := make([]*proto.UpdateItemRequest, len(req.Items))
+	for i, itm := range req.Items {
+		update := &proto.UpdateItemRequest{
+			Id:              itm.ID,
+			Title:           itm.Title,
+			Description:     itm.Description,
+			ImageUrl:        itm.ImageUrl,
+			UpdateMask:      &fieldmaskpb.FieldMask{},
+			ExpectedVersion: itm.Version,
+		}
+		if itm.Title != nil {
+			update.UpdateMask.Paths = append(update.UpdateMask.Paths, "title")
+		}
+		if itm.Description != nil {
+			update.UpdateMask.Paths = append(update.UpdateMask.Paths, "description")
+		}
+		if itm.Tags != nil {
+			update.Tags = *itm.Tags
+			update.UpdateMask.Paths = append(update.UpdateMask.Paths, "tags")
+		}
+		if itm.ImageUrl != nil {
+			update.UpdateMask.Paths = append(update.UpdateMask.Paths, "image_url")
+		}
+		items[i] = update
+	}
+
+	resp, err := a.catalogSvc.BatchUpdateItems(ctx, &proto.BatchUpdateItemsRequest{
+		Items: items,
+		Mode:  batchModes[req.Mode],
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	writeBatchResponse(c, resp)
+}
+
+func (a *App) handleBatchDeleteItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), batchTimeout)
+	defer cancel()
+
+	var req BatchDeleteItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	items := make([]*proto.DeleteItemRequest, len(req.Items))
+	for i, itm := range req.Items {
+		items[i] = &proto.DeleteItemRequest{Id: itm.ID, ExpectedVersion: itm.Version}
+	}
+
+	resp, err := a.catalogSvc.BatchDeleteItems(ctx, &proto.BatchDeleteItemsRequest{
+		Items: items,
+		Mode:  batchModes[req.Mode],
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	writeBatchResponse(c, resp)
+}
+
+// writeBatchResponse отвечает 200, если применены все элементы, и 207 Multi-Status, если хотя бы
+// один завершился ошибкой. Ошибки элементов описываются так же, как ошибки одиночных запросов.
+func writeBatchResponse(c *gin.Context, resp *proto.BatchItemsResponse) {
+	out := BatchItemsResponse{
+		Results:   make([]BatchItemResult, len(resp.Results)),
+		Succeeded: resp.Succeeded,
+		Failed:    resp.Failed,
+	}
+	for i, r := range resp.Results {
+		httpStatus, problem := itemProblem(c, r.Code, r.Reason, r.Message, r.Violations)
+		out.Results[i] = BatchItemResult{
+			Index:  r.Index,
+			ID:     r.Id,
+			Status: httpStatus,
+			Item:   r.Item,
+			Error:  problem,
+		}
+	}
+
+	httpStatus := http.StatusOK
+	if out.Failed > 0 {
+		httpStatus = http.StatusMultiStatus
+	}
+	c.JSON(httpStatus, out)
+}
+
+// itemProblem описывает ошибку одного элемента так же, как ошибку одиночного запроса.
+// Для codes.OK возвращает 200 и nil.
+func itemProblem(c *gin.Context, rawCode int32, reason, message string, violations []*proto.FieldViolation) (int, *middleware.Problem) {
+	code := codes.Code(rawCode)
+	httpStatus := httpStatusFromCode(code, reason)
+	if code == codes.OK {
+		return httpStatus, nil
+	}
+
+	detail := message
+	if httpStatus >= http.StatusInternalServerError {
+		detail = strings.ToLower(http.StatusText(httpStatus))
+	}
+	problem := middleware.NewProblem(c, httpStatus, code, detail)
+	problem.Reason = reason
+	for _, v := range violations {
+		problem.Violations = append(problem.Violations, middleware.FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	return httpStatus, problem
+}
@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type CreateCategoryRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	ParentID    string `json:"parent_id"`
+	Position    int32  `json:"position"`
+}
+
+type CategoryItemsRequest struct {
+	ItemIDs []string `json:"item_ids" binding:"required,min=1,max=1000"`
+}
+
+func (a *App) handleListCategories(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.ListCategories(ctx, &proto.ListCategoriesRequest{
+		ParentId: c.Query("parent_id"),
+		Page:     int32(page),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handleGetCategoryTree отдаёт всё дерево или ветку ?root=<id или slug> глубиной не больше ?max_depth
+func (a *App) handleGetCategoryTree(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	maxDepth, err := strconv.ParseInt(c.DefaultQuery("max_depth", "0"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.GetCategoryTree(ctx, &proto.GetCategoryTreeRequest{
+		Root:     c.Query("root"),
+		MaxDepth: int32(maxDepth),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handleGetCategory принимает в пути как id, так и slug категории
+func (a *App) handleGetCategory(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	resp, err := a.catalogSvc.GetCategory(ctx, &proto.GetCategoryRequest{Id: id})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleCreateCategory(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	var req CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.CreateCategory(ctx, &proto.CreateCategoryRequest{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		ParentId:    req.ParentID,
+		Position:    req.Position,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handlePatchCategory меняет только переданные поля. "parent_id": null переносит категорию в корень.
+func (a *App) handlePatchCategory(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	var patch map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		handleError(c, err)
+		return
+	}
+	if patch == nil {
+		handleError(c, status.Error(codes.InvalidArgument, "patch must be a JSON object"))
+		return
+	}
+
+	req := &proto.UpdateCategoryRequest{
+		Id:         id,
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+	for name, raw := range patch {
+		var target any
+		switch name {
+		case "name":
+			req.Name = new(string)
+			target = req.Name
+		case "slug":
+			req.Slug = new(string)
+			target = req.Slug
+		case "description":
+			req.Description = new(string)
+			target = req.Description
+		case "parent_id":
+			req.ParentId = new(string)
+			target = req.ParentId
+		case "position":
+			req.Position = new(int32)
+			target = req.Position
+		default:
+			handleError(c, status.Errorf(codes.InvalidArgument, "unknown field %q", name))
+			return
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, name)
+
+		// null оставляет значение пустым, и сервис очищает поле
+		if string(raw) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(raw, target); err != nil {
+			handleError(c, err)
+			return
+		}
+	}
+
+	resp, err := a.catalogSvc.UpdateCategory(ctx, req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleDeleteCategory(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	_, err := a.catalogSvc.DeleteCategory(ctx, &proto.DeleteCategoryRequest{Id: id})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, map[string]string{"message": "Category deleted"})
+}
+
+func (a *App) handleAddCategoryItems(c *gin.Context) {
+	a.changeCategoryItems(c, a.catalogSvc.AddCategoryItems)
+}
+
+func (a *App) handleRemoveCategoryItems(c *gin.Context) {
+	a.changeCategoryItems(c, a.catalogSvc.RemoveCategoryItems)
+}
+
+// changeCategoryItems отвечает списком missing_ids: элементы, которых нет в каталоге, пропускаются
+func (a *App) changeCategoryItems(c *gin.Context, call func(ctx context.Context, req *proto.CategoryItemsRequest, opts ...grpc.CallOption) (*proto.CategoryItemsResponse, error)) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	var req CategoryItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := call(ctx, &proto.CategoryItemsRequest{
+		CategoryId: id,
+		ItemIds:    req.ItemIDs,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"strings"
+)
+
+// itemETag строит сильный ETag из версии элемента
+func itemETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+func setItemETag(c *gin.Context, itm *proto.Item) {
+	if itm.GetVersion() > 0 {
+		c.Header("ETag", itemETag(itm.Version))
+	}
+}
+
+// parseIfMatch возвращает версию из заголовка If-Match.
+// nil означает, что условия нет: заголовок не передан или равен "*".
+// Слабый ETag по RFC 9110 никогда не совпадает в If-Match, поэтому сразу даёт 412.
+func parseIfMatch(c *gin.Context) (*int64, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	if strings.Contains(header, ",") {
+		return nil, status.Error(codes.InvalidArgument, "If-Match with multiple entity tags is not supported")
+	}
+	if strings.HasPrefix(header, "W/") {
+		return nil, errIfMatchMismatch("weak entity tags never match in If-Match")
+	}
+
+	unquoted, ok := strings.CutPrefix(header, `"`)
+	if ok {
+		unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if !ok || err != nil {
+		// Чужой ETag не может совпасть с версией элемента
+		return nil, errIfMatchMismatch("If-Match does not match the current item version")
+	}
+	return &version, nil
+}
+
+// errIfMatchMismatch описывает несовпадение If-Match так же, как каталог описывает
+// несовпадение версии, чтобы оба случая отдавались клиенту как 412
+func errIfMatchMismatch(msg string) error {
+	st := status.New(codes.FailedPrecondition, msg)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reasonVersionMismatch,
+		Domain: "api-gateway",
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -2,41 +2,124 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/neokofg/go-pet-microservices/api-gateway/middleware"
 	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	recommendpb "github.com/neokofg/go-pet-microservices/recommendation-service/api/proto"
+	searchpb "github.com/neokofg/go-pet-microservices/search-service/api/proto"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// mergePatchContentType - media type тела PATCH запросов по RFC 7396
+const mergePatchContentType = "application/merge-patch+json"
+
+// maxRecommendationLimit совпадает с ограничением recommendation-service
+const maxRecommendationLimit = 100
+
+const (
+	// RoleCatalogWrite даёт право изменять элементы каталога и модерировать отзывы
+	RoleCatalogWrite = "catalog:write"
+	// RoleAdmin даёт право управлять API ключами
+	RoleAdmin = "admin"
+)
+
 type App struct {
-	router     *gin.Engine
-	logger     *zap.Logger
-	catalogSvc proto.CatalogServiceClient
+	router       *gin.Engine
+	logger       *zap.Logger
+	catalogSvc   proto.CatalogServiceClient
+	apiKeySvc    proto.APIKeyServiceClient
+	searchSvc    searchpb.SearchServiceClient
+	recommendSvc recommendpb.RecommendationServiceClient
 }
 
 func NewApp(
 	logger *zap.Logger,
 	catalogSvc proto.CatalogServiceClient,
+	apiKeySvc proto.APIKeyServiceClient,
+	searchSvc searchpb.SearchServiceClient,
+	recommendSvc recommendpb.RecommendationServiceClient,
 ) *App {
 	return &App{
-		logger:     logger,
-		catalogSvc: catalogSvc,
+		logger:       logger,
+		catalogSvc:   catalogSvc,
+		apiKeySvc:    apiKeySvc,
+		searchSvc:    searchSvc,
+		recommendSvc: recommendSvc,
 	}
 }
 
 func (a *App) RegisterHandlers(r *gin.Engine) {
 	v1 := r.Group("/api/v1")
 	{
+		canWriteCatalog := middleware.RequireRoles(RoleCatalogWrite)
+
 		// Catalog endpoints
 		v1.GET("/items", a.handleGetItems)
+		v1.GET("/items/stream", a.handleStreamItems)
 		v1.GET("/items/:id", a.handleGetItem)
-		v1.POST("/items", a.handleCreateItem)
-		v1.PUT("/items/:id", a.handleUpdateItem)
-		v1.DELETE("/items/:id", a.handleDeleteItem)
+		v1.POST("/items", canWriteCatalog, a.handleCreateItem)
+		v1.POST("/items:action", a.authorizeItemsAction(canWriteCatalog), a.handleItemsAction)
+		v1.GET("/items:action", a.authorizeItemsAction(canWriteCatalog), a.handleItemsQuery)
+		v1.PUT("/items/:id", canWriteCatalog, a.handleUpdateItem)
+		v1.PATCH("/items/:id", canWriteCatalog, a.handlePatchItem)
+		v1.DELETE("/items/:id", canWriteCatalog, a.handleDeleteItem)
+
+		// Trash endpoints
+		v1.GET("/items/deleted", canWriteCatalog, a.handleListDeletedItems)
+		v1.POST("/items/:id/restore", canWriteCatalog, a.handleRestoreItem)
+
+		// History endpoints
+		v1.GET("/items/:id/history", canWriteCatalog, a.handleListItemHistory)
+		v1.POST("/items/:id/history/:revisionId/revert", canWriteCatalog, a.handleRevertItem)
+
+		// Category endpoints
+		v1.GET("/categories", a.handleListCategories)
+		v1.GET("/categories/tree", a.handleGetCategoryTree)
+		v1.GET("/categories/:id", a.handleGetCategory)
+		v1.POST("/categories", canWriteCatalog, a.handleCreateCategory)
+		v1.PATCH("/categories/:id", canWriteCatalog, a.handlePatchCategory)
+		v1.DELETE("/categories/:id", canWriteCatalog, a.handleDeleteCategory)
+		v1.POST("/categories/:id/items", canWriteCatalog, a.handleAddCategoryItems)
+		v1.DELETE("/categories/:id/items", canWriteCatalog, a.handleRemoveCategoryItems)
+
+		// Tag endpoints
+		v1.GET("/tags", a.handleListTags)
+		v1.POST("/tags", canWriteCatalog, a.handleCreateTag)
+		v1.PATCH("/tags/:id", canWriteCatalog, a.handlePatchTag)
+
+		// Search endpoints
+		v1.GET("/search", a.handleSearchItems)
+
+		// Review endpoints
+		v1.GET("/items/:id/reviews", a.handleListReviews)
+		v1.POST("/items/:id/reviews", middleware.RequireAuth(), a.handleCreateReview)
+		v1.DELETE("/items/:id/reviews/:reviewId", canWriteCatalog, a.handleDeleteReview)
+
+		// Recommendation endpoints
+		v1.GET("/items/popular", a.handleGetPopularItems)
+		v1.GET("/items/:id/similar", a.handleGetSimilarItems)
+	}
+
+	admin := v1.Group("/admin", middleware.RequireRoles(RoleAdmin))
+	{
+		// API key endpoints
+		admin.GET("/api-keys", a.handleListAPIKeys)
+		admin.POST("/api-keys", a.handleIssueAPIKey)
+		admin.DELETE("/api-keys/:id", a.handleRevokeAPIKey)
+
+		// Tag endpoints
+		admin.POST("/tags/merge", a.handleMergeTags)
 	}
 }
 
@@ -55,9 +138,34 @@ func (a *App) handleGetItems(c *gin.Context) {
 		return
 	}
 
+	tagMode, err := parseTagMode(c.DefaultQuery("tag_mode", "any"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	withTotal, err := strconv.ParseBool(c.DefaultQuery("with_total", "true"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	// По умолчанию ?category=<id или slug> включает и подкатегории, как при навигации по дереву
+	category := c.Query("category")
+	includeDescendants, err := strconv.ParseBool(c.DefaultQuery("include_descendants", "true"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
 	resp, err := a.catalogSvc.GetItems(ctx, &proto.GetItemsRequest{
-		Page:  int32(page),
-		Limit: int32(limit),
+		Page:               int32(page),
+		Limit:              int32(limit),
+		SortBy:             c.Query("sort"),
+		Tags:               parseTags(c.QueryArray("tags")),
+		TagMode:            tagMode,
+		Cursor:             c.Query("cursor"),
+		SkipTotal:          !withTotal,
+		Category:           category,
+		IncludeDescendants: includeDescendants && category != "",
 	})
 	if err != nil {
 		handleError(c, err)
@@ -67,6 +175,32 @@ func (a *App) handleGetItems(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// parseTags поддерживает как ?tags=a,b, так и ?tags=a&tags=b
+func parseTags(values []string) []string {
+	var tags []string
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
+
+func parseTagMode(mode string) (proto.TagMatchMode, error) {
+	switch strings.ToLower(mode) {
+	case "any":
+		return proto.TagMatchMode_TAG_MATCH_MODE_ANY, nil
+	case "all":
+		return proto.TagMatchMode_TAG_MATCH_MODE_ALL, nil
+	case "none":
+		return proto.TagMatchMode_TAG_MATCH_MODE_NONE, nil
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "unknown tag_mode %q, expected any, all or none", mode)
+	}
+}
+
 func (a *App) handleGetItem(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
@@ -81,6 +215,7 @@ func (a *App) handleGetItem(c *gin.Context) {
 		return
 	}
 
+	setItemETag(c, resp)
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -111,22 +246,38 @@ func (a *App) handleCreateItem(c *gin.Context) {
 		handleError(c, err)
 		return
 	}
+	setItemETag(c, resp)
 	c.JSON(http.StatusOK, resp)
 }
 
 type UpdateItemRequest struct {
-	Title       string   `json:"title"`
+	Title       string   `json:"title" binding:"required"`
 	Description string   `json:"description"`
 	Tags        []string `json:"tags"`
 	ImageUrl    string   `json:"imageUrl"`
 }
 
+// itemFieldsByJSONName сопоставляет поля тела запроса полям update_mask
+var itemFieldsByJSONName = map[string]string{
+	"title":       "title",
+	"description": "description",
+	"tags":        "tags",
+	"imageUrl":    "image_url",
+}
+
+// handleUpdateItem заменяет элемент целиком: поля, которых нет в теле, очищаются
 func (a *App) handleUpdateItem(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 
 	id, _ := c.Params.Get("id")
 
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
 	var req UpdateItemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		handleError(c, err)
@@ -134,25 +285,123 @@ func (a *App) handleUpdateItem(c *gin.Context) {
 	}
 
 	resp, err := a.catalogSvc.UpdateItem(ctx, &proto.UpdateItemRequest{
-		Id:          id,
-		Title:       &req.Title,
-		Description: &req.Description,
-		Tags:        req.Tags,
-		ImageUrl:    &req.ImageUrl,
+		Id:              id,
+		Title:           &req.Title,
+		Description:     &req.Description,
+		Tags:            req.Tags,
+		ImageUrl:        &req.ImageUrl,
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"*"}},
+		ExpectedVersion: expectedVersion,
 	})
 	if err != nil {
 		handleError(c, err)
 		return
 	}
+	setItemETag(c, resp)
 	c.JSON(http.StatusOK, resp)
 }
 
+// handlePatchItem применяет JSON Merge Patch (RFC 7396): меняются только поля из тела,
+// null очищает поле, массив tags заменяется целиком
+func (a *App) handlePatchItem(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	if ct := c.ContentType(); ct != mergePatchContentType && ct != binding.MIMEJSON {
+		middleware.WriteProblem(c, middleware.NewProblem(c, http.StatusUnsupportedMediaType, codes.InvalidArgument,
+			fmt.Sprintf("unsupported content type %q, expected %s", ct, mergePatchContentType)))
+		return
+	}
+
+	var patch map[string]json.RawMessage
+	err := json.NewDecoder(c.Request.Body).Decode(&patch)
+	var typeErr *json.UnmarshalTypeError
+	if err != nil && !errors.As(err, &typeErr) {
+		handleError(c, err)
+		return
+	}
+	if patch == nil {
+		handleError(c, status.Error(codes.InvalidArgument, "merge patch must be a JSON object"))
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	req := &proto.UpdateItemRequest{
+		Id:              id,
+		UpdateMask:      &fieldmaskpb.FieldMask{},
+		ExpectedVersion: expectedVersion,
+	}
+	for name, raw := range patch {
+		field, ok := itemFieldsByJSONName[name]
+		if !ok {
+			handleError(c, status.Errorf(codes.InvalidArgument, "unknown field %q", name))
+			return
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, field)
+
+		// null оставляет значение пустым, и сервис очищает поле
+		if string(raw) == "null" {
+			continue
+		}
+		if err := decodePatchField(req, field, raw); err != nil {
+			handleError(c, err)
+			return
+		}
+	}
+
+	resp, err := a.catalogSvc.UpdateItem(ctx, req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	setItemETag(c, resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+func decodePatchField(req *proto.UpdateItemRequest, field string, raw json.RawMessage) error {
+	if field == "tags" {
+		if err := json.Unmarshal(raw, &req.Tags); err != nil {
+			return status.Error(codes.InvalidArgument, "tags must be an array of strings")
+		}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s must be a string", field)
+	}
+	switch field {
+	case "title":
+		req.Title = &value
+	case "description":
+		req.Description = &value
+	case "image_url":
+		req.ImageUrl = &value
+	}
+	return nil
+}
+
 func (a *App) handleDeleteItem(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 	id, _ := c.Params.Get("id")
-	_, err := a.catalogSvc.DeleteItem(ctx, &proto.DeleteItemRequest{
-		Id: id,
+
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	_, err = a.catalogSvc.DeleteItem(ctx, &proto.DeleteItemRequest{
+		Id:              id,
+		ExpectedVersion: expectedVersion,
 	})
 	if err != nil {
 		handleError(c, err)
@@ -161,21 +410,96 @@ func (a *App) handleDeleteItem(c *gin.Context) {
 	c.JSON(http.StatusOK, map[string]string{"message": "Item deleted"})
 }
 
-func handleError(c *gin.Context, err error) {
-	st, ok := status.FromError(err)
-	if !ok {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+func (a *App) handleSearchItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	exact, err := strconv.ParseBool(c.DefaultQuery("exact", "false"))
+	if err != nil {
+		handleError(c, err)
 		return
 	}
 
-	switch st.Code() {
-	case codes.NotFound:
-		c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
-	case codes.InvalidArgument:
-		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
-	case codes.Unauthenticated:
-		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
-	default:
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+	resp, err := a.searchSvc.Search(ctx, &searchpb.SearchRequest{
+		Query: c.Query("q"),
+		Page:  int32(page),
+		Limit: int32(limit),
+		Tags:  parseTags(c.QueryArray("tags")),
+		Exact: exact,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
 	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleGetSimilarItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	limit, err := parseRecommendationLimit(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.recommendSvc.GetSimilarItems(ctx, &recommendpb.GetSimilarItemsRequest{
+		ItemId: id,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// parseRecommendationLimit читает ?limit= и отклоняет значения вне 1..maxRecommendationLimit
+func parseRecommendationLimit(c *gin.Context) (int64, error) {
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if limit < 1 || limit > maxRecommendationLimit {
+		return 0, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxRecommendationLimit)
+	}
+	return limit, nil
+}
+
+func (a *App) handleGetPopularItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	limit, err := parseRecommendationLimit(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.recommendSvc.GetTopRated(ctx, &recommendpb.GetTopRatedRequest{
+		Limit: int32(limit),
+		Tags:  parseTags(c.QueryArray("tags")),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
 }
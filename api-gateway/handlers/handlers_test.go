@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// fakeCatalog запоминает последний UpdateItemRequest; остальные методы не реализованы
+type fakeCatalog struct {
+	proto.CatalogServiceClient
+	update *proto.UpdateItemRequest
+}
+
+func (f *fakeCatalog) UpdateItem(_ context.Context, req *proto.UpdateItemRequest, _ ...grpc.CallOption) (*proto.Item, error) {
+	f.update = req
+	return &proto.Item{Id: req.Id, Version: 2}, nil
+}
+
+func maskOf(paths ...string) *fieldmaskpb.FieldMask {
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func TestHandlePatchItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	title := "Dune"
+	version := int64(1)
+	tests := []struct {
+		name        string
+		contentType string
+		ifMatch     string
+		body        string
+		wantStatus  int
+		// want сравнивается с запросом к каталогу; пути маски отсортированы
+		want *proto.UpdateItemRequest
+	}{
+		{
+			name:        "only fields from body",
+			contentType: mergePatchContentType,
+			body:        `{"title": "Dune"}`,
+			wantStatus:  http.StatusOK,
+			want:        &proto.UpdateItemRequest{Id: "item-1", Title: &title, UpdateMask: maskOf("title")},
+		},
+		{
+			name:        "null clears fields",
+			contentType: mergePatchContentType,
+			body:        `{"description": null, "imageUrl": null, "tags": null}`,
+			wantStatus:  http.StatusOK,
+			want:        &proto.UpdateItemRequest{Id: "item-1", UpdateMask: maskOf("description", "image_url", "tags")},
+		},
+		{
+			name:        "tags replaced as a whole",
+			contentType: "application/json",
+			ifMatch:     `"1"`,
+			body:        `{"tags": ["a", "b"]}`,
+			wantStatus:  http.StatusOK,
+			want:        &proto.UpdateItemRequest{Id: "item-1", Tags: []string{"a", "b"}, UpdateMask: maskOf("tags"), ExpectedVersion: &version},
+		},
+		{
+			name:        "empty patch",
+			contentType: mergePatchContentType,
+			body:        `{}`,
+			wantStatus:  http.StatusOK,
+			want:        &proto.UpdateItemRequest{Id: "item-1", UpdateMask: maskOf()},
+		},
+		{
+			name:        "unknown field",
+			contentType: mergePatchContentType,
+			body:        `{"rating": 5}`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "wrong type",
+			contentType: mergePatchContentType,
+			body:        `{"title": 5}`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "not an object",
+			contentType: mergePatchContentType,
+			body:        `["title"]`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "null document",
+			contentType: mergePatchContentType,
+			body:        `null`,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "text/plain",
+			body:        `{"title": "Dune"}`,
+			wantStatus:  http.StatusUnsupportedMediaType,
+		},
+		{
+			name:        "weak etag",
+			contentType: mergePatchContentType,
+			ifMatch:     `W/"1"`,
+			body:        `{"title": "Dune"}`,
+			wantStatus:  http.StatusPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := &fakeCatalog{}
+			a := &App{catalogSvc: catalog}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPatch, "/api/v1/items/item-1", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", tt.contentType)
+			if tt.ifMatch != "" {
+				c.Request.Header.Set("If-Match", tt.ifMatch)
+			}
+			c.Params = gin.Params{{Key: "id", Value: "item-1"}}
+
+			a.handlePatchItem(c)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if tt.want == nil {
+				if catalog.update != nil {
+					t.Errorf("catalog was called with %v", catalog.update)
+				}
+				var problem map[string]any
+				if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil || problem["status"] != float64(tt.wantStatus) {
+					t.Errorf("body is not a problem with status %d: %s", tt.wantStatus, w.Body)
+				}
+				return
+			}
+
+			got := catalog.update
+			if got == nil {
+				t.Fatal("catalog was not called")
+			}
+			sort.Strings(got.UpdateMask.Paths)
+			if got.Id != tt.want.Id ||
+				!reflect.DeepEqual(got.Title, tt.want.Title) ||
+				!reflect.DeepEqual(got.Description, tt.want.Description) ||
+				!reflect.DeepEqual(got.ImageUrl, tt.want.ImageUrl) ||
+				!reflect.DeepEqual(got.Tags, tt.want.Tags) ||
+				!reflect.DeepEqual(got.UpdateMask.Paths, tt.want.UpdateMask.Paths) ||
+				!reflect.DeepEqual(got.ExpectedVersion, tt.want.ExpectedVersion) {
+				t.Errorf("UpdateItem request = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
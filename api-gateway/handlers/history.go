@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (a *App) handleListItemHistory(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.ListItemRevisions(ctx, &proto.ListItemRevisionsRequest{
+		ItemId: id,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleRevertItem(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+	revisionID, _ := c.Params.Get("revisionId")
+
+	expectedVersion, err := parseIfMatch(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.RevertItem(ctx, &proto.RevertItemRequest{
+		ItemId:          id,
+		RevisionId:      revisionID,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	setItemETag(c, resp)
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/neokofg/go-pet-microservices/api-gateway/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"math"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// StatusClientClosedRequest - нестандартный статус nginx для запроса, который клиент отменил сам
+const StatusClientClosedRequest = 499
+
+func init() {
+	// Имена полей в ошибках binding берутся из json тегов, как их видит клиент
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			switch name {
+			case "-":
+				return ""
+			case "":
+				return f.Name
+			}
+			return name
+		})
+	}
+}
+
+// reasonVersionMismatch - причина в google.rpc.ErrorInfo, с которой каталог отвечает
+// на изменение устаревшей версии элемента
+const reasonVersionMismatch = "VERSION_MISMATCH"
+
+// httpStatusFromCode сопоставляет gRPC коды HTTP статусам так же, как grpc-gateway.
+// Исключение - FailedPrecondition с причиной VERSION_MISMATCH: это несовпадение
+// версии из If-Match, для него 412. Остальные FailedPrecondition остаются 400.
+func httpStatusFromCode(code codes.Code, reason string) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return StatusClientClosedRequest
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.FailedPrecondition:
+		if reason == reasonVersionMismatch {
+			return http.StatusPreconditionFailed
+		}
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		// Unknown, Internal, DataLoss и коды, которых ещё нет в этом списке
+		return http.StatusInternalServerError
+	}
+}
+
+// handleError отвечает на ошибку в формате application/problem+json.
+// Для 5xx сообщение сервиса не показывается клиенту: в нём могут быть адреса и детали реализации.
+func handleError(c *gin.Context, err error) {
+	middleware.WriteProblem(c, problemFromError(c, err))
+}
+
+func problemFromError(c *gin.Context, err error) *middleware.Problem {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return middleware.NewProblem(c, http.StatusRequestEntityTooLarge, codes.InvalidArgument,
+			fmt.Sprintf("request body must be at most %d bytes", tooLarge.Limit))
+	}
+
+	if violations, ok := requestErrorViolations(err); ok {
+		problem := middleware.NewProblem(c, http.StatusBadRequest, codes.InvalidArgument, "invalid request")
+		problem.Violations = violations
+		return problem
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		// context.Canceled станет 499, context.DeadlineExceeded - 504, остальное - 500
+		st = status.FromContextError(err)
+	}
+
+	httpStatus := httpStatusFromCode(st.Code(), errorInfoReason(st))
+	detail := st.Message()
+	if httpStatus >= http.StatusInternalServerError {
+		detail = strings.ToLower(http.StatusText(httpStatus))
+	}
+
+	problem := middleware.NewProblem(c, httpStatus, st.Code(), detail)
+	applyStatusDetails(c, problem, st)
+	return problem
+}
+
+// errorInfoReason возвращает причину из google.rpc.ErrorInfo или пустую строку
+func errorInfoReason(st *status.Status) string {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+// applyStatusDetails переносит в ответ детали gRPC статуса, которые имеют смысл для клиента.
+// DebugInfo намеренно не передаётся.
+func applyStatusDetails(c *gin.Context, problem *middleware.Problem, st *status.Status) {
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				problem.Violations = append(problem.Violations, middleware.FieldViolation{
+					Field:       v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		case *errdetails.PreconditionFailure:
+			for _, v := range d.GetViolations() {
+				problem.Preconditions = append(problem.Preconditions, middleware.PreconditionViolation{
+					Type:        v.GetType(),
+					Subject:     v.GetSubject(),
+					Description: v.GetDescription(),
+				})
+			}
+		case *errdetails.ErrorInfo:
+			problem.Reason = d.GetReason()
+			problem.Metadata = d.GetMetadata()
+		case *errdetails.RetryInfo:
+			if delay := d.GetRetryDelay(); delay != nil {
+				c.Header("Retry-After", strconv.Itoa(int(math.Ceil(delay.AsDuration().Seconds()))))
+			}
+		}
+	}
+}
+
+// requestErrorViolations распознаёт ошибки разбора запроса в самом шлюзе:
+// нарушения тегов binding, некорректный JSON и нечисловые параметры запроса
+func requestErrorViolations(err error) ([]middleware.FieldViolation, bool) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		violations := make([]middleware.FieldViolation, len(validationErrs))
+		for i, fe := range validationErrs {
+			violations[i] = middleware.FieldViolation{
+				Field:       jsonFieldName(fe),
+				Description: bindingDescription(fe),
+			}
+		}
+		return violations, true
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return []middleware.FieldViolation{{Field: typeErr.Field, Description: "must be of type " + typeErr.Type.String()}}, true
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return []middleware.FieldViolation{{Field: "body", Description: "must be a valid JSON document"}}, true
+	}
+
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		return []middleware.FieldViolation{{Field: "query", Description: fmt.Sprintf("invalid value %q", numErr.Num)}}, true
+	}
+
+	return nil, false
+}
+
+// jsonFieldName возвращает путь к полю без имени корневой структуры, например "tags[0]"
+func jsonFieldName(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if _, rest, ok := strings.Cut(namespace, "."); ok {
+		return rest
+	}
+	return namespace
+}
+
+func bindingDescription(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return "must be at least " + fe.Param()
+	case "max":
+		return "must be at most " + fe.Param()
+	case "oneof":
+		return "must be one of " + strings.Join(strings.Fields(fe.Param()), ", ")
+	default:
+		return "failed the " + fe.Tag() + " check"
+	}
+}
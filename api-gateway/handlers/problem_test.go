@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/neokofg/go-pet-microservices/api-gateway/middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHandleError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	badRequest, _ := status.New(codes.InvalidArgument, "invalid request: title: must not be empty").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "title", Description: "must not be empty"},
+			{Field: "tags[1]", Description: "duplicates tags[0]"},
+		},
+	})
+	precondition, _ := status.New(codes.FailedPrecondition, "category has subcategories").WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: "CATEGORY", Subject: "categories/c1", Description: "has subcategories"},
+		},
+	})
+	retry, _ := status.New(codes.ResourceExhausted, "too many requests").WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(1500 * time.Millisecond),
+	})
+	versionMismatch, _ := status.New(codes.FailedPrecondition, "item version mismatch: expected 2, current 3").WithDetails(&errdetails.ErrorInfo{
+		Reason:   "VERSION_MISMATCH",
+		Domain:   "catalog",
+		Metadata: map[string]string{"current_version": "3"},
+	})
+	otherReason, _ := status.New(codes.FailedPrecondition, "category is archived").WithDetails(&errdetails.ErrorInfo{
+		Reason: "CATEGORY_ARCHIVED",
+		Domain: "catalog",
+	})
+	debug, _ := status.New(codes.Internal, "pq: connection refused to 10.0.0.5").WithDetails(&errdetails.DebugInfo{
+		Detail: "stack trace",
+	})
+
+	tests := []struct {
+		name           string
+		err            error
+		want           middleware.Problem
+		wantRetryAfter string
+	}{
+		{
+			name: "not found",
+			err:  status.Error(codes.NotFound, "item not found"),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:not-found",
+				Title:  "Not Found",
+				Status: http.StatusNotFound,
+				Detail: "item not found",
+				Code:   "NotFound",
+			},
+		},
+		{
+			name: "field violations",
+			err:  badRequest.Err(),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:invalid-argument",
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "invalid request: title: must not be empty",
+				Code:   "InvalidArgument",
+				Violations: []middleware.FieldViolation{
+					{Field: "title", Description: "must not be empty"},
+					{Field: "tags[1]", Description: "duplicates tags[0]"},
+				},
+			},
+		},
+		{
+			name: "precondition failure",
+			err:  precondition.Err(),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:failed-precondition",
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "category has subcategories",
+				Code:   "FailedPrecondition",
+				Preconditions: []middleware.PreconditionViolation{
+					{Type: "CATEGORY", Subject: "categories/c1", Description: "has subcategories"},
+				},
+			},
+		},
+		{
+			name: "version mismatch",
+			err:  versionMismatch.Err(),
+			want: middleware.Problem{
+				Type:     "urn:go-pet-microservices:problem:failed-precondition",
+				Title:    "Precondition Failed",
+				Status:   http.StatusPreconditionFailed,
+				Detail:   "item version mismatch: expected 2, current 3",
+				Code:     "FailedPrecondition",
+				Reason:   "VERSION_MISMATCH",
+				Metadata: map[string]string{"current_version": "3"},
+			},
+		},
+		{
+			name: "failed precondition with other reason",
+			err:  otherReason.Err(),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:failed-precondition",
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "category is archived",
+				Code:   "FailedPrecondition",
+				Reason: "CATEGORY_ARCHIVED",
+			},
+		},
+		{
+			name: "aborted",
+			err:  status.Error(codes.Aborted, "transaction conflict"),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:aborted",
+				Title:  "Conflict",
+				Status: http.StatusConflict,
+				Detail: "transaction conflict",
+				Code:   "Aborted",
+			},
+		},
+		{
+			name: "retry info",
+			err:  retry.Err(),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:resource-exhausted",
+				Title:  "Too Many Requests",
+				Status: http.StatusTooManyRequests,
+				Detail: "too many requests",
+				Code:   "ResourceExhausted",
+			},
+			wantRetryAfter: "2",
+		},
+		{
+			name: "internal detail is hidden",
+			err:  debug.Err(),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:internal",
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "internal server error",
+				Code:   "Internal",
+			},
+		},
+		{
+			name: "unavailable detail is hidden",
+			err:  status.Error(codes.Unavailable, "dial tcp 10.0.0.5:50051: connection refused"),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:unavailable",
+				Title:  "Service Unavailable",
+				Status: http.StatusServiceUnavailable,
+				Detail: "service unavailable",
+				Code:   "Unavailable",
+			},
+		},
+		{
+			name: "binding validation",
+			err:  binding.Validator.ValidateStruct(&IssueAPIKeyRequest{TTLSeconds: -1}),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:invalid-argument",
+				Title:  "Bad Request",
+				Status: http.StatusBadRequest,
+				Detail: "invalid request",
+				Code:   "InvalidArgument",
+				Violations: []middleware.FieldViolation{
+					{Field: "name", Description: "is required"},
+					{Field: "ttl_seconds", Description: "must be at least 0"},
+				},
+			},
+		},
+		{
+			name: "malformed json",
+			err:  json.Unmarshal([]byte(`{"title":`), &struct{}{}),
+			want: middleware.Problem{
+				Type:       "urn:go-pet-microservices:problem:invalid-argument",
+				Title:      "Bad Request",
+				Status:     http.StatusBadRequest,
+				Detail:     "invalid request",
+				Code:       "InvalidArgument",
+				Violations: []middleware.FieldViolation{{Field: "body", Description: "must be a valid JSON document"}},
+			},
+		},
+		{
+			name: "wrong json type",
+			err: json.Unmarshal([]byte(`{"rating":"five"}`), &struct {
+				Rating int32 `json:"rating"`
+			}{}),
+			want: middleware.Problem{
+				Type:       "urn:go-pet-microservices:problem:invalid-argument",
+				Title:      "Bad Request",
+				Status:     http.StatusBadRequest,
+				Detail:     "invalid request",
+				Code:       "InvalidArgument",
+				Violations: []middleware.FieldViolation{{Field: "rating", Description: "must be of type int32"}},
+			},
+		},
+		{
+			name: "body too large",
+			err:  &http.MaxBytesError{Limit: 1024},
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:invalid-argument",
+				Title:  "Request Entity Too Large",
+				Status: http.StatusRequestEntityTooLarge,
+				Detail: "request body must be at most 1024 bytes",
+				Code:   "InvalidArgument",
+			},
+		},
+		{
+			name: "client canceled",
+			err:  context.Canceled,
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:canceled",
+				Title:  "Client Closed Request",
+				Status: StatusClientClosedRequest,
+				Detail: context.Canceled.Error(),
+				Code:   "Canceled",
+			},
+		},
+		{
+			name: "deadline exceeded",
+			err:  context.DeadlineExceeded,
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:deadline-exceeded",
+				Title:  "Gateway Timeout",
+				Status: http.StatusGatewayTimeout,
+				Detail: "gateway timeout",
+				Code:   "DeadlineExceeded",
+			},
+		},
+		{
+			name: "plain error",
+			err:  errors.New("boom"),
+			want: middleware.Problem{
+				Type:   "urn:go-pet-microservices:problem:unknown",
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "internal server error",
+				Code:   "Unknown",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(middleware.RequestIDMiddleware())
+			router.GET("/api/v1/items/:id", func(c *gin.Context) { handleError(c, tt.err) })
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/items/i1", nil)
+			req.Header.Set(middleware.RequestIDHeader, "req-1")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.want.Status {
+				t.Errorf("status = %d, want %d", w.Code, tt.want.Status)
+			}
+			if ct := w.Header().Get("Content-Type"); ct != middleware.ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", ct, middleware.ProblemContentType)
+			}
+			if got := w.Header().Get("Retry-After"); got != tt.wantRetryAfter {
+				t.Errorf("Retry-After = %q, want %q", got, tt.wantRetryAfter)
+			}
+			var got middleware.Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode body %s: %v", w.Body, err)
+			}
+			want := tt.want
+			want.Instance = "/api/v1/items/i1"
+			want.RequestID = "req-1"
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("problem = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseIfMatch(t *testing.T) {
+	version := int64(3)
+	tests := []struct {
+		header         string
+		want           *int64
+		wantCode       codes.Code
+		wantHTTPStatus int
+	}{
+		{"", nil, codes.OK, 0},
+		{"*", nil, codes.OK, 0},
+		{`"3"`, &version, codes.OK, 0},
+		{`"1", "2"`, nil, codes.InvalidArgument, http.StatusBadRequest},
+		{`W/"3"`, nil, codes.FailedPrecondition, http.StatusPreconditionFailed},
+		{`"abc"`, nil, codes.FailedPrecondition, http.StatusPreconditionFailed},
+		{"3", nil, codes.FailedPrecondition, http.StatusPreconditionFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/items/i1", nil)
+			c.Request.Header.Set("If-Match", tt.header)
+
+			got, err := parseIfMatch(c)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("version = %v, want %v", got, tt.want)
+			}
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			if err == nil {
+				return
+			}
+			// Несовпадение ETag в шлюзе должно давать тот же статус, что и несовпадение версии в каталоге
+			if got := httpStatusFromCode(st.Code(), errorInfoReason(st)); got != tt.wantHTTPStatus {
+				t.Errorf("http status = %d, want %d", got, tt.wantHTTPStatus)
+			}
+		})
+	}
+}
+
+func TestItemProblem(t *testing.T) {
+	tests := []struct {
+		name       string
+		code       codes.Code
+		reason     string
+		wantStatus int
+	}{
+		{"ok", codes.OK, "", http.StatusOK},
+		{"version mismatch", codes.FailedPrecondition, reasonVersionMismatch, http.StatusPreconditionFailed},
+		{"failed precondition", codes.FailedPrecondition, "", http.StatusBadRequest},
+		{"aborted by other item", codes.Aborted, "", http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/items/batch", nil)
+
+			httpStatus, problem := itemProblem(c, int32(tt.code), tt.reason, "message", nil)
+			if httpStatus != tt.wantStatus {
+				t.Errorf("status = %d, want %d", httpStatus, tt.wantStatus)
+			}
+			if tt.code == codes.OK {
+				if problem != nil {
+					t.Errorf("problem = %+v, want nil", problem)
+				}
+				return
+			}
+			if problem.Status != tt.wantStatus || problem.Reason != tt.reason {
+				t.Errorf("problem status = %d, reason = %q, want %d, %q", problem.Status, problem.Reason, tt.wantStatus, tt.reason)
+			}
+		})
+	}
+}
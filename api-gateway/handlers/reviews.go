@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/api-gateway/middleware"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (a *App) handleListReviews(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.ListReviews(ctx, &proto.ListReviewsRequest{
+		ItemId: id,
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+type CreateReviewRequest struct {
+	Score int32  `json:"score" binding:"required,min=1,max=5"`
+	Text  string `json:"text"`
+}
+
+func (a *App) handleCreateReview(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	var req CreateReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	// Автор отзыва - всегда текущий пользователь, маршрут защищён RequireAuth
+	identity, _ := middleware.IdentityFromContext(c.Request.Context())
+
+	resp, err := a.catalogSvc.CreateReview(ctx, &proto.CreateReviewRequest{
+		ItemId: id,
+		Author: identity.Subject,
+		Score:  req.Score,
+		Text:   req.Text,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleDeleteReview(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+	reviewID, _ := c.Params.Get("reviewId")
+
+	_, err := a.catalogSvc.DeleteReview(ctx, &proto.DeleteReviewRequest{
+		ItemId: id,
+		Id:     reviewID,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, map[string]string{"message": "Review deleted"})
+}
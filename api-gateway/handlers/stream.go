@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// sseKeepAliveInterval - как часто отправлять комментарий, чтобы прокси не закрыли простаивающее соединение
+const sseKeepAliveInterval = 15 * time.Second
+
+// handleStreamItems транслирует WatchItems в Server-Sent Events.
+// id события - sequence изменения, поэтому браузер при переподключении сам передаёт
+// Last-Event-ID и получает пропущенные изменения.
+func (a *App) handleStreamItems(c *gin.Context) {
+	after, err := parseStreamPosition(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	// Поток живёт, пока клиент не отключится, поэтому общий 5-секундный таймаут здесь не подходит
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	stream, err := a.catalogSvc.WatchItems(ctx, &proto.WatchItemsRequest{
+		Tags:          parseTags(c.QueryArray("tags")),
+		Ids:           parseTags(c.QueryArray("ids")),
+		AfterSequence: after,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	// Каталог отправляет заголовки после проверки запроса. Если вызов завершился ошибкой
+	// без заголовков, Header возвращает пустые метаданные, а сама ошибка приходит из Recv.
+	md, err := stream.Header()
+	if err == nil && md == nil {
+		_, err = stream.Recv()
+	}
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	events := make(chan *proto.ItemEvent)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	// Отключает буферизацию ответа в nginx
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := io.WriteString(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case event := <-events:
+			data, err := json.Marshal(event)
+			if err != nil {
+				a.logger.Error("Failed to encode item event", zap.Error(err))
+				return
+			}
+			if _, err := fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.Sequence, event.Type, data); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return
+			}
+			a.writeStreamError(c, err)
+			return
+		}
+	}
+}
+
+// writeStreamError отправляет ошибку событием error: статус ответа уже отправлен.
+// Тело события - тот же problem+json, что и у обычных ответов.
+func (a *App) writeStreamError(c *gin.Context, err error) {
+	data, err := json.Marshal(problemFromError(c, err))
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(c.Writer, "event: error\ndata: %s\n\n", data)
+	c.Writer.Flush()
+}
+
+// parseStreamPosition берёт позицию из Last-Event-ID, который браузер передаёт при
+// переподключении, или из параметра after для первого подключения
+func parseStreamPosition(c *gin.Context) (int64, error) {
+	value := c.GetHeader("Last-Event-ID")
+	if value == "" {
+		value = c.Query("after")
+	}
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
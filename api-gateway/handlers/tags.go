@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type CreateTagRequest struct {
+	Name        string   `json:"name" binding:"required"`
+	Synonyms    []string `json:"synonyms"`
+	Description string   `json:"description"`
+}
+
+type MergeTagsRequest struct {
+	Sources []string `json:"sources" binding:"required,min=1,max=100"`
+	Target  string   `json:"target" binding:"required"`
+}
+
+// handleListTags отдаёт теги по убыванию числа элементов. С ?prefix= работает как автодополнение.
+func (a *App) handleListTags(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.ListTags(ctx, &proto.ListTagsRequest{
+		Prefix: c.Query("prefix"),
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleCreateTag(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	var req CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.CreateTag(ctx, &proto.CreateTagRequest{
+		Name:        req.Name,
+		Synonyms:    req.Synonyms,
+		Description: req.Description,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handlePatchTag меняет description и synonyms; synonyms заменяются целиком, null их очищает
+func (a *App) handlePatchTag(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	var patch map[string]json.RawMessage
+	if err := c.ShouldBindJSON(&patch); err != nil {
+		handleError(c, err)
+		return
+	}
+	if patch == nil {
+		handleError(c, status.Error(codes.InvalidArgument, "patch must be a JSON object"))
+		return
+	}
+
+	req := &proto.UpdateTagRequest{
+		Id:         id,
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+	for name, raw := range patch {
+		var target any
+		switch name {
+		case "description":
+			req.Description = new(string)
+			target = req.Description
+		case "synonyms":
+			target = &req.Synonyms
+		default:
+			handleError(c, status.Errorf(codes.InvalidArgument, "unknown field %q", name))
+			return
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, name)
+
+		if string(raw) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(raw, target); err != nil {
+			handleError(c, err)
+			return
+		}
+	}
+
+	resp, err := a.catalogSvc.UpdateTag(ctx, req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handleMergeTags объединяет теги. Слияние переписывает все элементы с тегами sources,
+// поэтому ему дано столько же времени, сколько импорту.
+func (a *App) handleMergeTags(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), transferTimeout)
+	defer cancel()
+
+	var req MergeTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.MergeTags(ctx, &proto.MergeTagsRequest{
+		Sources: req.Sources,
+		Target:  req.Target,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
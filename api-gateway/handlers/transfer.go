@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/api-gateway/middleware"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxImportBodySize ограничивает загружаемый файл; тело читается частями и целиком в памяти не хранится
+	maxImportBodySize = 256 << 20
+	// transferChunkSize - размер части файла в одном gRPC сообщении
+	transferChunkSize = 64 << 10
+	// transferTimeout рассчитан на выгрузку и загрузку сотен тысяч элементов
+	transferTimeout = 10 * time.Minute
+)
+
+// dataFormats - форматы файлов по имени из параметра format
+var dataFormats = map[string]proto.DataFormat{
+	"csv":     proto.DataFormat_DATA_FORMAT_CSV,
+	"jsonl":   proto.DataFormat_DATA_FORMAT_JSONL,
+	"parquet": proto.DataFormat_DATA_FORMAT_PARQUET,
+}
+
+// dataFormatsByContentType позволяет не указывать format, если клиент передал Content-Type файла
+var dataFormatsByContentType = map[string]proto.DataFormat{
+	"text/csv":                       proto.DataFormat_DATA_FORMAT_CSV,
+	"application/x-ndjson":           proto.DataFormat_DATA_FORMAT_JSONL,
+	"application/jsonl":              proto.DataFormat_DATA_FORMAT_JSONL,
+	"application/vnd.apache.parquet": proto.DataFormat_DATA_FORMAT_PARQUET,
+}
+
+var dataFormatContentTypes = map[proto.DataFormat]string{
+	proto.DataFormat_DATA_FORMAT_CSV:     "text/csv; charset=utf-8",
+	proto.DataFormat_DATA_FORMAT_JSONL:   "application/x-ndjson",
+	proto.DataFormat_DATA_FORMAT_PARQUET: "application/vnd.apache.parquet",
+}
+
+var dataFormatExtensions = map[proto.DataFormat]string{
+	proto.DataFormat_DATA_FORMAT_CSV:     "csv",
+	proto.DataFormat_DATA_FORMAT_JSONL:   "jsonl",
+	proto.DataFormat_DATA_FORMAT_PARQUET: "parquet",
+}
+
+// ImportRowResult - ошибка в строке файла, описанная так же, как ошибка одиночного запроса
+type ImportRowResult struct {
+	Row        int32               `json:"row"`
+	ExternalID string              `json:"external_id,omitempty"`
+	Status     int                 `json:"status"`
+	Error      *middleware.Problem `json:"error"`
+}
+
+type ImportItemsResponse struct {
+	DryRun          bool              `json:"dry_run"`
+	Committed       bool              `json:"committed"`
+	Rows            int32             `json:"rows"`
+	Created         int32             `json:"created"`
+	Updated         int32             `json:"updated"`
+	Failed          int32             `json:"failed"`
+	Errors          []ImportRowResult `json:"errors"`
+	ErrorsTruncated bool              `json:"errors_truncated"`
+}
+
+// handleItemsQuery обслуживает GET /items:<action>
+func (a *App) handleItemsQuery(c *gin.Context) {
+	switch c.Param("action") {
+	case actionExport:
+		a.handleExportItems(c)
+	default:
+		middleware.WriteProblem(c, middleware.NewProblem(c, http.StatusNotFound, codes.NotFound, "unknown action"))
+	}
+}
+
+// handleImportItems передаёт тело запроса в ImportItems частями по мере чтения.
+// Отвечает 200, если все строки приняты или это dry run, 207 - если часть строк отклонена,
+// и 422, если из-за ошибок в атомарном режиме ничего не сохранено.
+func (a *App) handleImportItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), transferTimeout)
+	defer cancel()
+
+	opts, err := parseImportOptions(c)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	stream, err := a.catalogSvc.ImportItems(ctx)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	err = stream.Send(&proto.ImportItemsRequest{Payload: &proto.ImportItemsRequest_Options{Options: opts}})
+	for err == nil {
+		chunk := make([]byte, transferChunkSize)
+		n, readErr := c.Request.Body.Read(chunk)
+		if n > 0 {
+			err = stream.Send(&proto.ImportItemsRequest{Payload: &proto.ImportItemsRequest_Chunk{Chunk: chunk[:n]}})
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			// Отмена контекста обрывает вызов, и каталог откатывает начатый импорт
+			cancel()
+			handleError(c, readErr)
+			return
+		}
+	}
+	// Send возвращает io.EOF, если каталог уже завершил вызов; настоящую ошибку вернёт CloseAndRecv
+	if err != nil && !errors.Is(err, io.EOF) {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	out := ImportItemsResponse{
+		DryRun:          resp.DryRun,
+		Committed:       resp.Committed,
+		Rows:            resp.Rows,
+		Created:         resp.Created,
+		Updated:         resp.Updated,
+		Failed:          resp.Failed,
+		Errors:          make([]ImportRowResult, len(resp.Errors)),
+		ErrorsTruncated: resp.ErrorsTruncated,
+	}
+	for i, e := range resp.Errors {
+		httpStatus, problem := itemProblem(c, e.Code, e.Reason, e.Message, e.Violations)
+		out.Errors[i] = ImportRowResult{
+			Row:        e.Row,
+			ExternalID: e.ExternalId,
+			Status:     httpStatus,
+			Error:      problem,
+		}
+	}
+
+	httpStatus := http.StatusOK
+	switch {
+	case out.Failed == 0 || out.DryRun:
+	case out.Committed:
+		httpStatus = http.StatusMultiStatus
+	default:
+		httpStatus = http.StatusUnprocessableEntity
+	}
+	c.JSON(httpStatus, out)
+}
+
+// parseImportOptions читает параметры импорта из query: format (или Content-Type тела),
+// columns, upsert, dry_run, mode и separator
+func parseImportOptions(c *gin.Context) (*proto.ImportOptions, error) {
+	format, err := parseDataFormat(c.Query("format"), c.GetHeader("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	upsert, err := strconv.ParseBool(c.DefaultQuery("upsert", "false"))
+	if err != nil {
+		return nil, err
+	}
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		return nil, err
+	}
+	mode, ok := batchModes[c.Query("mode")]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown mode %q, expected atomic or best_effort", c.Query("mode"))
+	}
+
+	return &proto.ImportOptions{
+		Format:        format,
+		Columns:       parseColumnMappings(c.QueryArray("columns")),
+		Upsert:        upsert,
+		DryRun:        dryRun,
+		Mode:          mode,
+		ListSeparator: c.Query("separator"),
+	}, nil
+}
+
+// handleExportItems отдаёт файл из ExportItems, отправляя клиенту каждую часть сразу после получения
+func (a *App) handleExportItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), transferTimeout)
+	defer cancel()
+
+	format, err := parseDataFormat(c.DefaultQuery("format", "csv"), "")
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	tagMode, err := parseTagMode(c.DefaultQuery("tag_mode", "any"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	stream, err := a.catalogSvc.ExportItems(ctx, &proto.ExportItemsRequest{
+		Format:        format,
+		Columns:       parseColumnMappings(c.QueryArray("columns")),
+		Tags:          parseTags(c.QueryArray("tags")),
+		TagMode:       tagMode,
+		ListSeparator: c.Query("separator"),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	// Ошибку проверки запроса ещё можно вернуть обычным ответом, пока не отправлен статус
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", dataFormatContentTypes[format])
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": "items." + dataFormatExtensions[format],
+	}))
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	for resp := first; resp != nil; {
+		if _, err := c.Writer.Write(resp.Chunk); err != nil {
+			return
+		}
+		c.Writer.Flush()
+
+		resp, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			// Отключение клиента - не ошибка выгрузки
+			if c.Request.Context().Err() == nil {
+				a.logger.Error("Export stream failed", zap.Error(err))
+			}
+			abortResponse(c)
+			return
+		}
+	}
+}
+
+// abortResponse обрывает соединение, чтобы клиент не принял недописанный файл за целый:
+// статус 200 уже отправлен, и сообщить об ошибке иначе нельзя
+func abortResponse(c *gin.Context) {
+	conn, _, err := c.Writer.Hijack()
+	if err != nil {
+		return
+	}
+	conn.Close()
+}
+
+func parseDataFormat(name, contentType string) (proto.DataFormat, error) {
+	if name != "" {
+		format, ok := dataFormats[strings.ToLower(name)]
+		if !ok {
+			return 0, status.Errorf(codes.InvalidArgument, "unknown format %q, expected csv, jsonl or parquet", name)
+		}
+		return format, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if format, ok := dataFormatsByContentType[mediaType]; ok {
+		return format, nil
+	}
+	return 0, status.Error(codes.InvalidArgument, "format is required: pass ?format=csv|jsonl|parquet or a matching Content-Type")
+}
+
+// parseColumnMappings разбирает ?columns=title:Name,tags: колонка указывается после двоеточия,
+// без неё называется как поле. Как и теги, значения можно передать списком через запятую.
+func parseColumnMappings(values []string) []*proto.ColumnMapping {
+	var mappings []*proto.ColumnMapping
+	for _, value := range parseTags(values) {
+		field, column, _ := strings.Cut(value, ":")
+		mappings = append(mappings, &proto.ColumnMapping{
+			Field:  strings.TrimSpace(field),
+			Column: strings.TrimSpace(column),
+		})
+	}
+	return mappings
+}
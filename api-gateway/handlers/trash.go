@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (a *App) handleListDeletedItems(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	page, err := strconv.ParseInt(c.DefaultQuery("page", "1"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	resp, err := a.catalogSvc.ListDeletedItems(ctx, &proto.ListDeletedItemsRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func (a *App) handleRestoreItem(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	id, _ := c.Params.Get("id")
+
+	resp, err := a.catalogSvc.RestoreItem(ctx, &proto.RestoreItemRequest{
+		Id: id,
+	})
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	setItemETag(c, resp)
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APIKeyHeader - заголовок, в котором машинные клиенты передают API ключ
+const APIKeyHeader = "X-API-Key"
+
+// APIKeySubjectPrefix отличает личность API ключа от пользователей из JWT
+const APIKeySubjectPrefix = "apikey:"
+
+// apiKeyCacheSize ограничивает число записей в кэше: ключи для отказов приходят от клиента
+// и без ограничения перебор случайных ключей раздувал бы память
+const apiKeyCacheSize = 10000
+
+type apiKeyCacheEntry struct {
+	hash    [sha256.Size]byte
+	key     *proto.APIKey
+	expires time.Time
+}
+
+// apiKeyCache хранит результаты проверки ключей, включая отказы, чтобы не обращаться
+// к каталогу на каждый запрос. Ключи кэша - хэши, сами ключи в памяти не хранятся.
+// При переполнении вытесняется давно не использованная запись.
+type apiKeyCache struct {
+	ttl     time.Duration
+	size    int
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*list.Element
+	// lru упорядочен от недавно использованных записей к давним
+	lru *list.List
+}
+
+func newAPIKeyCache(ttl time.Duration, size int) *apiKeyCache {
+	return &apiKeyCache{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[[sha256.Size]byte]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (c *apiKeyCache) get(hash [sha256.Size]byte, now time.Time) (*proto.APIKey, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[hash]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*apiKeyCacheEntry)
+	if !now.Before(entry.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, hash)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return entry.key, true
+}
+
+// put кэширует результат проверки на ttl, но не дольше срока действия самого ключа:
+// иначе истёкший ключ принимался бы ещё до ttl
+func (c *apiKeyCache) put(hash [sha256.Size]byte, key *proto.APIKey, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := now.Add(c.ttl)
+	if key != nil && key.ExpiresAt != "" {
+		if keyExpires, err := time.Parse(time.RFC3339, key.ExpiresAt); err == nil && keyExpires.Before(expires) {
+			expires = keyExpires
+		}
+	}
+
+	if elem, ok := c.entries[hash]; ok {
+		entry := elem.Value.(*apiKeyCacheEntry)
+		entry.key = key
+		entry.expires = expires
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	for c.lru.Len() >= c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*apiKeyCacheEntry).hash)
+	}
+	c.entries[hash] = c.lru.PushFront(&apiKeyCacheEntry{hash: hash, key: key, expires: expires})
+}
+
+// APIKeyMiddleware аутентифицирует машинных клиентов по заголовку X-API-Key.
+// Ключ проверяется в каталоге, результат кэшируется на ttl, поэтому отзыв ключа
+// вступает в силу с задержкой до ttl. Scopes ключа становятся ролями его личности.
+// Обращения к каталогу за ключами, которых нет в кэше, ограничиваются lookupLimit
+// на IP адрес клиента: иначе перебор ключей не ограничен ничем, так как
+// неверный ключ отклоняется раньше RateLimiterMiddleware.
+// Должен стоять перед RateLimiterMiddleware, чтобы запросы учитывались в корзине ключа.
+func APIKeyMiddleware(client proto.APIKeyServiceClient, logger *zap.Logger, ttl time.Duration, store RateLimitStore, lookupLimit RateLimit) gin.HandlerFunc {
+	cache := newAPIKeyCache(ttl, apiKeyCacheSize)
+
+	return func(c *gin.Context) {
+		raw := strings.TrimSpace(c.GetHeader(APIKeyHeader))
+		if raw == "" {
+			c.Next()
+			return
+		}
+
+		now := time.Now()
+		hash := sha256.Sum256([]byte(raw))
+		key, ok := cache.get(hash, now)
+		if !ok {
+			decision, err := store.Take(c.Request.Context(), "api_key_lookup:ip:"+c.ClientIP(), lookupLimit, now)
+			if err != nil {
+				rateLimiterErrorsTotal.WithLabelValues("api_key_lookup").Inc()
+				logger.Error("Rate limiter store failed", zap.String("group", "api_key_lookup"), zap.Error(err))
+			} else if !decision.Allowed {
+				rateLimitedRequestsTotal.WithLabelValues("api_key_lookup", "ip").Inc()
+				c.Header("Retry-After", strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+				AbortWithProblem(c, http.StatusTooManyRequests, codes.ResourceExhausted, "too many api key attempts")
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
+			resp, err := client.ValidateAPIKey(ctx, &proto.ValidateAPIKeyRequest{Key: raw})
+			cancel()
+			if err != nil && status.Code(err) != codes.Unauthenticated {
+				logger.Error("Failed to validate api key", zap.Error(err))
+				AbortWithProblem(c, http.StatusServiceUnavailable, codes.Unavailable, "api key validation is unavailable")
+				return
+			}
+			key = resp
+			cache.put(hash, key, now)
+		}
+
+		if key == nil {
+			c.Header("WWW-Authenticate", `ApiKey realm="api"`)
+			AbortWithProblem(c, http.StatusUnauthorized, codes.Unauthenticated, "invalid api key")
+			return
+		}
+
+		identity := &Identity{
+			Subject: APIKeySubjectPrefix + key.Id,
+			Roles:   key.Scopes,
+		}
+		c.Set(APIKeyIDKey, key.Id)
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), identityContextKey{}, identity))
+		c.Next()
+	}
+}
@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"github.com/gin-gonic/gin"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAPIKeyCache(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	hash := func(key string) [sha256.Size]byte { return sha256.Sum256([]byte(key)) }
+	valid := &proto.APIKey{Id: "valid"}
+	expiring := &proto.APIKey{Id: "expiring", ExpiresAt: start.Add(3 * time.Second).Format(time.RFC3339)}
+
+	// Шаги выполняются по порядку над одним кэшем на 10 секунд и 2 записи.
+	// put кладёт key под хэш name, иначе шаг проверяет get.
+	steps := []struct {
+		name    string
+		at      time.Duration
+		put     bool
+		key     string
+		value   *proto.APIKey
+		wantHit bool
+		wantKey *proto.APIKey
+	}{
+		{name: "miss", key: "a"},
+		{name: "put valid", put: true, key: "a", value: valid},
+		{name: "hit", at: 9 * time.Second, key: "a", wantHit: true, wantKey: valid},
+		{name: "expired after ttl", at: 10 * time.Second, key: "a"},
+		{name: "put rejection", at: 10 * time.Second, put: true, key: "b"},
+		{name: "rejection is cached", at: 11 * time.Second, key: "b", wantHit: true},
+		{name: "put expiring key", at: 0, put: true, key: "c", value: expiring},
+		{name: "hit before key expiry", at: 2 * time.Second, key: "c", wantHit: true, wantKey: expiring},
+		{name: "key expiry is earlier than ttl", at: 3 * time.Second, key: "c"},
+	}
+
+	cache := newAPIKeyCache(10*time.Second, 2)
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			now := start.Add(step.at)
+			if step.put {
+				cache.put(hash(step.key), step.value, now)
+				return
+			}
+			got, ok := cache.get(hash(step.key), now)
+			if ok != step.wantHit || got != step.wantKey {
+				t.Errorf("get(%s) = %v, %v, want %v, %v", step.key, got, ok, step.wantKey, step.wantHit)
+			}
+		})
+	}
+}
+
+func TestAPIKeyCacheEviction(t *testing.T) {
+	now := time.Now()
+	hash := func(key string) [sha256.Size]byte { return sha256.Sum256([]byte(key)) }
+	cache := newAPIKeyCache(time.Minute, 2)
+	cache.put(hash("a"), &proto.APIKey{Id: "a"}, now)
+	cache.put(hash("b"), &proto.APIKey{Id: "b"}, now)
+	// a использован позже b, поэтому при переполнении вытесняется b
+	cache.get(hash("a"), now)
+	cache.put(hash("c"), &proto.APIKey{Id: "c"}, now)
+
+	for key, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, ok := cache.get(hash(key), now); ok != want {
+			t.Errorf("%s cached = %v, want %v", key, ok, want)
+		}
+	}
+	if cache.lru.Len() != 2 || len(cache.entries) != 2 {
+		t.Errorf("cache holds %d list and %d map entries, want 2", cache.lru.Len(), len(cache.entries))
+	}
+}
+
+// fakeAPIKeyClient проверяет ключи по словарю и считает обращения к каталогу
+type fakeAPIKeyClient struct {
+	proto.APIKeyServiceClient
+
+	mu    sync.Mutex
+	keys  map[string]*proto.APIKey
+	calls int
+}
+
+func (f *fakeAPIKeyClient) ValidateAPIKey(_ context.Context, req *proto.ValidateAPIKeyRequest, _ ...grpc.CallOption) (*proto.APIKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if req.Key == "gpk_down" {
+		return nil, status.Error(codes.Unavailable, "catalog is down")
+	}
+	key, ok := f.keys[req.Key]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	return key, nil
+}
+
+func (f *fakeAPIKeyClient) revoke(key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.keys, key)
+}
+
+func (f *fakeAPIKeyClient) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const ttl = 50 * time.Millisecond
+
+	catalog := &fakeAPIKeyClient{keys: map[string]*proto.APIKey{
+		"gpk_valid": {Id: "key-1", Scopes: []string{"catalog:write"}},
+	}}
+	// Три обращения к каталогу на IP, новые почти не появляются за время теста
+	lookupLimit := RateLimit{Rate: 0.01, Burst: 3}
+
+	router := gin.New()
+	router.Use(APIKeyMiddleware(catalog, zap.NewNop(), ttl, NewMemoryRateLimitStore(), lookupLimit))
+	router.GET("/", func(c *gin.Context) {
+		if identity, ok := IdentityFromContext(c.Request.Context()); ok {
+			c.Header("X-Subject", identity.Subject)
+		}
+		c.Header("X-Key-ID", c.GetString(APIKeyIDKey))
+		c.Status(http.StatusNoContent)
+	})
+
+	// Шаги выполняются по порядку; calls - сколько всего раз к этому моменту вызван каталог
+	steps := []struct {
+		name       string
+		before     func()
+		ip         string
+		key        string
+		wantStatus int
+		wantKeyID  string
+		calls      int
+	}{
+		{name: "no key", ip: "10.0.0.1", wantStatus: http.StatusNoContent, calls: 0},
+		{name: "valid key", ip: "10.0.0.1", key: "gpk_valid", wantStatus: http.StatusNoContent, wantKeyID: "key-1", calls: 1},
+		{name: "valid key from cache", ip: "10.0.0.1", key: " gpk_valid ", wantStatus: http.StatusNoContent, wantKeyID: "key-1", calls: 1},
+		{name: "invalid key", ip: "10.0.0.1", key: "gpk_invalid", wantStatus: http.StatusUnauthorized, calls: 2},
+		{name: "invalid key from cache", ip: "10.0.0.1", key: "gpk_invalid", wantStatus: http.StatusUnauthorized, calls: 2},
+		{
+			name:       "revoked key is accepted until ttl",
+			before:     func() { catalog.revoke("gpk_valid") },
+			ip:         "10.0.0.1",
+			key:        "gpk_valid",
+			wantStatus: http.StatusNoContent,
+			wantKeyID:  "key-1",
+			calls:      2,
+		},
+		{
+			name:       "revoked key is rejected after ttl",
+			before:     func() { time.Sleep(ttl) },
+			ip:         "10.0.0.1",
+			key:        "gpk_valid",
+			wantStatus: http.StatusUnauthorized,
+			calls:      3,
+		},
+		{name: "lookups throttled per ip", ip: "10.0.0.1", key: "gpk_guess", wantStatus: http.StatusTooManyRequests, calls: 3},
+		{name: "other ip is not throttled", ip: "10.0.0.2", key: "gpk_guess", wantStatus: http.StatusUnauthorized, calls: 4},
+		{name: "catalog unavailable", ip: "10.0.0.3", key: "gpk_down", wantStatus: http.StatusServiceUnavailable, calls: 5},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			if step.before != nil {
+				step.before()
+			}
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = step.ip + ":1234"
+			if step.key != "" {
+				req.Header.Set(APIKeyHeader, step.key)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != step.wantStatus {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, step.wantStatus, w.Body)
+			}
+			if calls := catalog.callCount(); calls != step.calls {
+				t.Errorf("catalog calls = %d, want %d", calls, step.calls)
+			}
+			if step.wantKeyID != "" {
+				if got := w.Header().Get("X-Key-ID"); got != step.wantKeyID {
+					t.Errorf("key id = %q, want %q", got, step.wantKeyID)
+				}
+				if got, want := w.Header().Get("X-Subject"), APIKeySubjectPrefix+step.wantKeyID; got != want {
+					t.Errorf("subject = %q, want %q", got, want)
+				}
+			}
+			switch step.wantStatus {
+			case http.StatusUnauthorized:
+				if w.Header().Get("WWW-Authenticate") == "" {
+					t.Error("401 response has no WWW-Authenticate header")
+				}
+			case http.StatusTooManyRequests:
+				if got := w.Header().Get("Retry-After"); got != "100" {
+					t.Errorf("Retry-After = %q, want %q", got, "100")
+				}
+			}
+		})
+	}
+}
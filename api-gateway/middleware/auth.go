@@ -0,0 +1,209 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"net/http"
+	"strings"
+)
+
+// Ключи gRPC метаданных, в которых шлюз передаёт личность пользователя в сервисы
+const (
+	UserIDMetadataKey    = "x-user-id"
+	UserRolesMetadataKey = "x-user-roles"
+)
+
+// Identity - пользователь, извлечённый из проверенного JWT
+type Identity struct {
+	Subject string
+	Roles   []string
+}
+
+func (i *Identity) HasRole(role string) bool {
+	for _, r := range i.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type identityContextKey struct{}
+
+// IdentityFromContext возвращает личность пользователя, если запрос был аутентифицирован
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey{}).(*Identity)
+	return identity, ok
+}
+
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+	// Scope - роли в формате OAuth2, через пробел
+	Scope string `json:"scope"`
+}
+
+// TokenVerifier проверяет подпись и стандартные claims JWT.
+// HS256 токены проверяются общим секретом, RS256 - ключами из JWKS.
+type TokenVerifier struct {
+	hmacSecret []byte
+	jwks       *JWKS
+	parser     *jwt.Parser
+}
+
+func NewTokenVerifier(hmacSecret []byte, jwks *JWKS, issuer, audience string) *TokenVerifier {
+	var methods []string
+	if len(hmacSecret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if jwks != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+
+	return &TokenVerifier{
+		hmacSecret: hmacSecret,
+		jwks:       jwks,
+		parser:     jwt.NewParser(opts...),
+	}
+}
+
+func (v *TokenVerifier) keyFunc(token *jwt.Token) (any, error) {
+	// Ключ выбирается по типу метода, а не по заголовку alg, чтобы RSA ключ
+	// нельзя было использовать как HMAC секрет
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return v.hmacSecret, nil
+	case *jwt.SigningMethodRSA:
+		kid, _ := token.Header["kid"].(string)
+		key, ok := v.jwks.Key(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unexpected signing method %q", token.Method.Alg())
+	}
+}
+
+func (v *TokenVerifier) Verify(raw string) (*Identity, error) {
+	var claims tokenClaims
+	if _, err := v.parser.ParseWithClaims(raw, &claims, v.keyFunc); err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	roles := append([]string(nil), claims.Roles...)
+	roles = append(roles, strings.Fields(claims.Scope)...)
+	return &Identity{
+		Subject: claims.Subject,
+		Roles:   roles,
+	}, nil
+}
+
+// AuthMiddleware проверяет Bearer токен из заголовка Authorization.
+// Запросы без токена проходят анонимно, доступ к маршрутам ограничивают RequireAuth и RequireRoles.
+// Запросы с недействительным токеном отклоняются с 401.
+// Если запрос уже аутентифицирован API ключом, заголовок Authorization не проверяется.
+func AuthMiddleware(verifier *TokenVerifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if _, ok := IdentityFromContext(c.Request.Context()); header == "" || ok {
+			c.Next()
+			return
+		}
+
+		scheme, token, ok := strings.Cut(header, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") {
+			abortUnauthorized(c, "invalid authorization header")
+			return
+		}
+
+		identity, err := verifier.Verify(strings.TrimSpace(token))
+		if err != nil {
+			abortUnauthorized(c, "invalid token")
+			return
+		}
+
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), identityContextKey{}, identity))
+		c.Next()
+	}
+}
+
+// RequireAuth пропускает только аутентифицированные запросы
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := IdentityFromContext(c.Request.Context()); !ok {
+			abortUnauthorized(c, "authentication required")
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireRoles пропускает только запросы пользователей, у которых есть все перечисленные роли
+func RequireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity, ok := IdentityFromContext(c.Request.Context())
+		if !ok {
+			abortUnauthorized(c, "authentication required")
+			return
+		}
+		for _, role := range roles {
+			if !identity.HasRole(role) {
+				AbortWithProblem(c, http.StatusForbidden, codes.PermissionDenied, "missing required role "+role)
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+func abortUnauthorized(c *gin.Context, message string) {
+	c.Header("WWW-Authenticate", `Bearer realm="api"`)
+	AbortWithProblem(c, http.StatusUnauthorized, codes.Unauthenticated, message)
+}
+
+// withIdentityMetadata добавляет личность пользователя из ctx в исходящие gRPC метаданные
+func withIdentityMetadata(ctx context.Context) context.Context {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx,
+		UserIDMetadataKey, identity.Subject,
+		UserRolesMetadataKey, strings.Join(identity.Roles, ","),
+	)
+}
+
+// IdentityUnaryClientInterceptor передаёт личность пользователя в вызываемый сервис
+func IdentityUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(withIdentityMetadata(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// IdentityStreamClientInterceptor передаёт личность пользователя в вызываемый сервис для потоковых вызовов
+func IdentityStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(withIdentityMetadata(ctx), desc, cc, method, opts...)
+	}
+}
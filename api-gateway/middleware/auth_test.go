@@ -0,0 +1,392 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testIssuer   = "https://issuer.test"
+	testAudience = "catalog"
+)
+
+var testHMACSecret = []byte("test-secret")
+
+// jwksServer отдаёт набор ключей, который тест может заменить, имитируя ротацию
+type jwksServer struct {
+	*httptest.Server
+
+	mu   sync.Mutex
+	keys map[string]*rsa.PrivateKey
+	fail bool
+}
+
+func newJWKSServer(t *testing.T, keys map[string]*rsa.PrivateKey) *jwksServer {
+	s := &jwksServer{keys: keys}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) set(keys map[string]*rsa.PrivateKey, fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	s.fail = fail
+}
+
+func (s *jwksServer) serve(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	set := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	for kid, key := range s.keys {
+		set.Keys = append(set.Keys, jsonWebKey{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	json.NewEncoder(w).Encode(set)
+}
+
+func generateRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	return key
+}
+
+// validClaims возвращает claims, которые проходят проверку; change меняет их под случай теста
+func validClaims(change func(*tokenClaims)) tokenClaims {
+	claims := tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			Issuer:    testIssuer,
+			Audience:  jwt.ClaimStrings{testAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles: []string{"editor"},
+		Scope: "items:read items:write",
+	}
+	if change != nil {
+		change(&claims)
+	}
+	return claims
+}
+
+func signHS(t *testing.T, method jwt.SigningMethod, secret []byte, claims tokenClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(secret)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func signRS(t *testing.T, key *rsa.PrivateKey, kid string, claims tokenClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func TestTokenVerifier(t *testing.T) {
+	current := generateRSAKey(t)
+	other := generateRSAKey(t)
+	server := newJWKSServer(t, map[string]*rsa.PrivateKey{"current": current})
+	jwks, err := NewJWKS(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("NewJWKS: %v", err)
+	}
+	verifier := NewTokenVerifier(testHMACSecret, jwks, testIssuer, testAudience)
+
+	tests := []struct {
+		name    string
+		token   string
+		want    *Identity
+		wantErr bool
+	}{
+		{
+			name:  "hs256",
+			token: signHS(t, jwt.SigningMethodHS256, testHMACSecret, validClaims(nil)),
+			want:  &Identity{Subject: "user-1", Roles: []string{"editor", "items:read", "items:write"}},
+		},
+		{
+			name:  "rs256",
+			token: signRS(t, current, "current", validClaims(nil)),
+			want:  &Identity{Subject: "user-1", Roles: []string{"editor", "items:read", "items:write"}},
+		},
+		{
+			name:  "rs256 without kid and a single key",
+			token: signRS(t, current, "", validClaims(nil)),
+			want:  &Identity{Subject: "user-1", Roles: []string{"editor", "items:read", "items:write"}},
+		},
+		{
+			name: "expired",
+			token: signHS(t, jwt.SigningMethodHS256, testHMACSecret, validClaims(func(c *tokenClaims) {
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+			})),
+			wantErr: true,
+		},
+		{
+			name: "no expiration",
+			token: signHS(t, jwt.SigningMethodHS256, testHMACSecret, validClaims(func(c *tokenClaims) {
+				c.ExpiresAt = nil
+			})),
+			wantErr: true,
+		},
+		{
+			name:    "wrong algorithm",
+			token:   signHS(t, jwt.SigningMethodHS512, testHMACSecret, validClaims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "alg none",
+			token:   mustSignNone(t, validClaims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "unknown kid",
+			token:   signRS(t, current, "retired", validClaims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "bad rs256 signature",
+			token:   signRS(t, other, "current", validClaims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "bad hs256 signature",
+			token:   signHS(t, jwt.SigningMethodHS256, []byte("other-secret"), validClaims(nil)),
+			wantErr: true,
+		},
+		{
+			name:    "tampered payload",
+			token:   tamper(signHS(t, jwt.SigningMethodHS256, testHMACSecret, validClaims(nil))),
+			wantErr: true,
+		},
+		{
+			name: "wrong issuer",
+			token: signHS(t, jwt.SigningMethodHS256, testHMACSecret, validClaims(func(c *tokenClaims) {
+				c.Issuer = "https://other.test"
+			})),
+			wantErr: true,
+		},
+		{
+			name: "wrong audience",
+			token: signHS(t, jwt.SigningMethodHS256, testHMACSecret, validClaims(func(c *tokenClaims) {
+				c.Audience = jwt.ClaimStrings{"billing"}
+			})),
+			wantErr: true,
+		},
+		{
+			name: "no subject",
+			token: signHS(t, jwt.SigningMethodHS256, testHMACSecret, validClaims(func(c *tokenClaims) {
+				c.Subject = ""
+			})),
+			wantErr: true,
+		},
+		{
+			name:    "not a jwt",
+			token:   "garbage",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifier.Verify(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenVerifierWithoutJWKS(t *testing.T) {
+	// Без JWKS RS256 не входит в допустимые алгоритмы, и ключ для него не ищется
+	verifier := NewTokenVerifier(testHMACSecret, nil, "", "")
+	token := signRS(t, generateRSAKey(t), "current", validClaims(nil))
+	if _, err := verifier.Verify(token); err == nil {
+		t.Error("Verify() accepted rs256 token without jwks")
+	}
+}
+
+func TestJWKSRefresh(t *testing.T) {
+	oldKey := generateRSAKey(t)
+	newKey := generateRSAKey(t)
+	server := newJWKSServer(t, map[string]*rsa.PrivateKey{"old": oldKey})
+	jwks, err := NewJWKS(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("NewJWKS: %v", err)
+	}
+	verifier := NewTokenVerifier(nil, jwks, "", "")
+	oldToken := signRS(t, oldKey, "old", validClaims(nil))
+	newToken := signRS(t, newKey, "new", validClaims(nil))
+
+	// Шаги выполняются по порядку: каждый задаёт ключи на сервере, обновляет JWKS и проверяет оба токена
+	steps := []struct {
+		name       string
+		keys       map[string]*rsa.PrivateKey
+		fail       bool
+		wantErr    bool
+		wantOldKey bool
+		wantNewKey bool
+	}{
+		{"new key is published", map[string]*rsa.PrivateKey{"old": oldKey, "new": newKey}, false, false, true, true},
+		{"failed refresh keeps keys", nil, true, true, true, true},
+		{"old key is retired", map[string]*rsa.PrivateKey{"new": newKey}, false, false, false, true},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			server.set(step.keys, step.fail)
+			if err := jwks.Refresh(context.Background()); (err != nil) != step.wantErr {
+				t.Fatalf("Refresh() error = %v, wantErr %v", err, step.wantErr)
+			}
+			if _, err := verifier.Verify(oldToken); (err == nil) != step.wantOldKey {
+				t.Errorf("old key token accepted = %v, want %v", err == nil, step.wantOldKey)
+			}
+			if _, err := verifier.Verify(newToken); (err == nil) != step.wantNewKey {
+				t.Errorf("new key token accepted = %v, want %v", err == nil, step.wantNewKey)
+			}
+		})
+	}
+}
+
+func TestJWKSRefreshPeriodically(t *testing.T) {
+	key := generateRSAKey(t)
+	server := newJWKSServer(t, nil)
+	jwks, err := NewJWKS(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("NewJWKS: %v", err)
+	}
+	server.set(map[string]*rsa.PrivateKey{"rotated": key}, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go jwks.RefreshPeriodically(ctx, 10*time.Millisecond, nil)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, ok := jwks.Key("rotated"); ok {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("rotated key was not loaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	verifier := NewTokenVerifier(testHMACSecret, nil, testIssuer, testAudience)
+	valid := signHS(t, jwt.SigningMethodHS256, testHMACSecret, validClaims(nil))
+
+	tests := []struct {
+		name          string
+		authorization string
+		guard         gin.HandlerFunc
+		wantStatus    int
+		wantSubject   string
+	}{
+		{"anonymous", "", nil, http.StatusNoContent, ""},
+		{"valid token", "Bearer " + valid, nil, http.StatusNoContent, "user-1"},
+		{"scheme is case insensitive", "bearer " + valid, nil, http.StatusNoContent, "user-1"},
+		{"other scheme", "Basic dXNlcjpwYXNz", nil, http.StatusUnauthorized, ""},
+		{"invalid token", "Bearer garbage", nil, http.StatusUnauthorized, ""},
+		{"auth required, anonymous", "", RequireAuth(), http.StatusUnauthorized, ""},
+		{"auth required, valid token", "Bearer " + valid, RequireAuth(), http.StatusNoContent, "user-1"},
+		{"role from roles claim", "Bearer " + valid, RequireRoles("editor"), http.StatusNoContent, "user-1"},
+		{"role from scope", "Bearer " + valid, RequireRoles("items:write"), http.StatusNoContent, "user-1"},
+		{"missing role", "Bearer " + valid, RequireRoles("editor", "admin"), http.StatusForbidden, ""},
+		{"role required, anonymous", "", RequireRoles("editor"), http.StatusUnauthorized, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handlers := []gin.HandlerFunc{AuthMiddleware(verifier)}
+			if tt.guard != nil {
+				handlers = append(handlers, tt.guard)
+			}
+			var subject string
+			handlers = append(handlers, func(c *gin.Context) {
+				if identity, ok := IdentityFromContext(c.Request.Context()); ok {
+					subject = identity.Subject
+				}
+				c.Status(http.StatusNoContent)
+			})
+			router := gin.New()
+			router.GET("/", handlers...)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if subject != tt.wantSubject {
+				t.Errorf("subject = %q, want %q", subject, tt.wantSubject)
+			}
+			if w.Code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+				t.Error("401 response has no WWW-Authenticate header")
+			}
+		})
+	}
+}
+
+func mustSignNone(t *testing.T, claims tokenClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+// tamper заменяет subject в уже подписанном токене, не трогая подпись
+func tamper(token string) string {
+	parts := strings.Split(token, ".")
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	var claims map[string]any
+	json.Unmarshal(payload, &claims)
+	claims["sub"] = "admin"
+	payload, _ = json.Marshal(claims)
+	parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+	return strings.Join(parts, ".")
+}
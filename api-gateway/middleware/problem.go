@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"net/http"
+	"strings"
+)
+
+// ProblemContentType - media type ошибок по RFC 7807
+const ProblemContentType = "application/problem+json"
+
+// problemTypeBase - префикс URI типа ошибки. URN не разыменовывается,
+// клиенты сравнивают type как идентификатор.
+const problemTypeBase = "urn:go-pet-microservices:problem:"
+
+// Problem - тело ошибки в формате application/problem+json.
+// Помимо полей RFC 7807 содержит gRPC код, id запроса и детали из gRPC статуса.
+// Общий для middleware и обработчиков, чтобы все ошибки шлюза выглядели одинаково.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	RequestID string `json:"request_id,omitempty"`
+	Code      string `json:"code,omitempty"`
+	// Reason и Metadata берутся из google.rpc.ErrorInfo
+	Reason        string                  `json:"reason,omitempty"`
+	Metadata      map[string]string       `json:"metadata,omitempty"`
+	Violations    []FieldViolation        `json:"violations,omitempty"`
+	Preconditions []PreconditionViolation `json:"preconditions,omitempty"`
+}
+
+// FieldViolation - ошибка в конкретном поле запроса
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// PreconditionViolation - невыполненное условие из google.rpc.PreconditionFailure
+type PreconditionViolation struct {
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+}
+
+// problemSlug превращает имя кода в часть URI типа: FailedPrecondition -> failed-precondition
+func problemSlug(code codes.Code) string {
+	var b strings.Builder
+	for i, r := range code.String() {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// NewProblem заполняет общие поля ошибки из текущего запроса
+func NewProblem(c *gin.Context, httpStatus int, code codes.Code, detail string) *Problem {
+	title := http.StatusText(httpStatus)
+	if title == "" {
+		// У 499 нет стандартного текста
+		title = "Client Closed Request"
+	}
+	return &Problem{
+		Type:      problemTypeBase + problemSlug(code),
+		Title:     title,
+		Status:    httpStatus,
+		Detail:    detail,
+		Instance:  c.Request.URL.RequestURI(),
+		RequestID: RequestIDFromContext(c.Request.Context()),
+		Code:      code.String(),
+	}
+}
+
+// WriteProblem отвечает ошибкой, не прерывая цепочку обработчиков
+func WriteProblem(c *gin.Context, problem *Problem) {
+	body, err := json.Marshal(problem)
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Data(problem.Status, ProblemContentType, body)
+}
+
+// AbortWithProblem отвечает ошибкой и прерывает цепочку, как AbortWithStatusJSON
+func AbortWithProblem(c *gin.Context, httpStatus int, code codes.Code, detail string) {
+	c.Abort()
+	WriteProblem(c, NewProblem(c, httpStatus, code, detail))
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestProblemSlug(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want string
+	}{
+		{codes.NotFound, "not-found"},
+		{codes.FailedPrecondition, "failed-precondition"},
+		{codes.Unauthenticated, "unauthenticated"},
+		{codes.ResourceExhausted, "resource-exhausted"},
+		{codes.DataLoss, "data-loss"},
+	}
+	for _, tt := range tests {
+		if got := problemSlug(tt.code); got != tt.want {
+			t.Errorf("problemSlug(%v) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestAbortWithProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		httpStatus int
+		code       codes.Code
+		detail     string
+		want       Problem
+	}{
+		{
+			name:       "unauthenticated",
+			httpStatus: http.StatusUnauthorized,
+			code:       codes.Unauthenticated,
+			detail:     "invalid token",
+			want: Problem{
+				Type:      "urn:go-pet-microservices:problem:unauthenticated",
+				Title:     "Unauthorized",
+				Status:    http.StatusUnauthorized,
+				Detail:    "invalid token",
+				Instance:  "/api/v1/items?page=2",
+				RequestID: "req-1",
+				Code:      "Unauthenticated",
+			},
+		},
+		{
+			name:       "status without standard text",
+			httpStatus: 499,
+			code:       codes.Canceled,
+			want: Problem{
+				Type:      "urn:go-pet-microservices:problem:canceled",
+				Title:     "Client Closed Request",
+				Status:    499,
+				Instance:  "/api/v1/items?page=2",
+				RequestID: "req-1",
+				Code:      "Canceled",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reached bool
+			router := gin.New()
+			router.Use(RequestIDMiddleware())
+			router.GET("/api/v1/items", func(c *gin.Context) {
+				AbortWithProblem(c, tt.httpStatus, tt.code, tt.detail)
+			}, func(c *gin.Context) {
+				reached = true
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/items?page=2", nil)
+			req.Header.Set(RequestIDHeader, "req-1")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.httpStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.httpStatus)
+			}
+			if ct := w.Header().Get("Content-Type"); ct != ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", ct, ProblemContentType)
+			}
+			if reached {
+				t.Error("handler after AbortWithProblem was called")
+			}
+			var got Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode body %s: %v", w.Body, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("problem = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
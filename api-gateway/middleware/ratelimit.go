@@ -0,0 +1,222 @@
+package middleware
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APIKeyIDKey - ключ gin.Context, под которым аутентификация по API ключу сохраняет id ключа.
+// Если он задан, запрос учитывается в корзине ключа, а не IP адреса клиента.
+const APIKeyIDKey = "api_key_id"
+
+var (
+	rateLimitedRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_rate_limited_requests_total",
+			Help: "Total number of HTTP requests rejected by the rate limiter",
+		},
+		[]string{"group", "client_type"},
+	)
+
+	rateLimiterErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_rate_limiter_errors_total",
+			Help: "Total number of rate limiter store errors (requests are allowed on error)",
+		},
+		[]string{"group"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(rateLimitedRequestsTotal)
+	prometheus.MustRegister(rateLimiterErrorsTotal)
+}
+
+// RateLimit - параметры корзины токенов: Rate токенов в секунду и не больше Burst токенов в запасе
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitRule задаёт лимиты для группы маршрутов.
+// Запрос попадает в первое правило, у которого совпал префикс пути и метод.
+type RateLimitRule struct {
+	// Name входит в ключ корзины и в метки метрик
+	Name   string
+	Prefix string
+	// Methods ограничивает правило HTTP методами. Пустой список - любые методы.
+	Methods []string
+	// Limit применяется к клиентам, которые определяются по IP адресу
+	Limit RateLimit
+	// APIKeyLimit применяется к клиентам с API ключом. Нулевое значение - такой же, как Limit.
+	APIKeyLimit RateLimit
+}
+
+func (r RateLimitRule) matches(method, path string) bool {
+	if !strings.HasPrefix(path, r.Prefix) {
+		return false
+	}
+	if len(r.Methods) == 0 {
+		return true
+	}
+	for _, m := range r.Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// RateLimitDecision - результат попытки взять токен из корзины
+type RateLimitDecision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAfter - время, через которое корзина снова будет полной
+	ResetAfter time.Duration
+	// RetryAfter - время до появления следующего токена, если запрос отклонён
+	RetryAfter time.Duration
+}
+
+// RateLimitStore хранит состояние корзин.
+// MemoryRateLimitStore подходит для одной реплики шлюза; при нескольких репликах
+// нужна общая реализация, например на Redis с атомарным скриптом.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit RateLimit, now time.Time) (RateLimitDecision, error)
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+	// full - момент, когда корзина наполнится и её можно удалить из памяти
+	full time.Time
+}
+
+// take пополняет корзину за прошедшее время и пытается списать один токен
+func (b *tokenBucket) take(limit RateLimit, now time.Time) RateLimitDecision {
+	burst := float64(limit.Burst)
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(burst, b.tokens+elapsed*limit.Rate)
+		b.last = now
+	}
+
+	decision := RateLimitDecision{Limit: limit.Burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = secondsToDuration((1 - b.tokens) / limit.Rate)
+	}
+	decision.Remaining = int(b.tokens)
+	decision.ResetAfter = secondsToDuration((burst - b.tokens) / limit.Rate)
+	b.full = now.Add(decision.ResetAfter)
+	return decision
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// MemoryRateLimitStore хранит корзины в памяти процесса.
+// Полные корзины периодически удаляются, так как они не отличаются от новых.
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+const sweepInterval = time.Minute
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+func (s *MemoryRateLimitStore) Take(_ context.Context, key string, limit RateLimit, now time.Time) (RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		for k, b := range s.buckets {
+			if !now.Before(b.full) {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(limit.Burst), last: now}
+		s.buckets[key] = b
+	}
+	return b.take(limit, now), nil
+}
+
+// RateLimiterMiddleware ограничивает частоту запросов по алгоритму корзины токенов.
+// Корзина заводится на пару (правило, клиент), клиент определяется по API ключу или по IP адресу.
+// Запросы, не попавшие ни в одно правило, не ограничиваются. При ошибке хранилища запрос пропускается.
+func RateLimiterMiddleware(store RateLimitStore, logger *zap.Logger, rules ...RateLimitRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var rule *RateLimitRule
+		for i := range rules {
+			if rules[i].matches(c.Request.Method, c.Request.URL.Path) {
+				rule = &rules[i]
+				break
+			}
+		}
+		if rule == nil {
+			c.Next()
+			return
+		}
+
+		limit := rule.Limit
+		clientType := "ip"
+		client := c.ClientIP()
+		if keyID := c.GetString(APIKeyIDKey); keyID != "" {
+			clientType = "api_key"
+			client = keyID
+			if rule.APIKeyLimit.Burst > 0 {
+				limit = rule.APIKeyLimit
+			}
+		}
+
+		key := rule.Name + ":" + clientType + ":" + client
+		decision, err := store.Take(c.Request.Context(), key, limit, time.Now())
+		if err != nil {
+			rateLimiterErrorsTotal.WithLabelValues(rule.Name).Inc()
+			logger.Error("Rate limiter store failed", zap.String("group", rule.Name), zap.Error(err))
+			c.Next()
+			return
+		}
+
+		header := c.Writer.Header()
+		header.Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+		header.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		header.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.ResetAfter)))
+		header.Set("RateLimit-Policy", strconv.Itoa(limit.Burst)+";w="+strconv.Itoa(ceilSeconds(secondsToDuration(float64(limit.Burst)/limit.Rate))))
+
+		if !decision.Allowed {
+			rateLimitedRequestsTotal.WithLabelValues(rule.Name, clientType).Inc()
+			header.Set("Retry-After", strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+			AbortWithProblem(c, http.StatusTooManyRequests, codes.ResourceExhausted, "rate limit exceeded")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	limit := RateLimit{Rate: 1, Burst: 2}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		name      string
+		key       string
+		at        time.Duration
+		want      bool
+		remaining int
+		retry     time.Duration
+		reset     time.Duration
+	}{
+		{"burst, first token", "a", 0, true, 1, 0, time.Second},
+		{"burst, second token", "a", 0, true, 0, 0, 2 * time.Second},
+		{"bucket empty", "a", 0, false, 0, time.Second, 2 * time.Second},
+		{"half a token refilled", "a", 500 * time.Millisecond, false, 0, 500 * time.Millisecond, 1500 * time.Millisecond},
+		{"one token refilled", "a", time.Second, true, 0, 0, 2 * time.Second},
+		{"other key has its own bucket", "b", time.Second, true, 1, 0, time.Second},
+		{"refill is capped at burst", "a", time.Hour, true, 1, 0, time.Second},
+	}
+
+	store := NewMemoryRateLimitStore()
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			got, err := store.Take(context.Background(), step.key, limit, start.Add(step.at))
+			if err != nil {
+				t.Fatalf("Take: %v", err)
+			}
+			want := RateLimitDecision{
+				Allowed:    step.want,
+				Limit:      limit.Burst,
+				Remaining:  step.remaining,
+				ResetAfter: step.reset,
+				RetryAfter: step.retry,
+			}
+			if got != want {
+				t.Errorf("Take() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+type failingRateLimitStore struct{}
+
+func (failingRateLimitStore) Take(context.Context, string, RateLimit, time.Time) (RateLimitDecision, error) {
+	return RateLimitDecision{}, errors.New("store unavailable")
+}
+
+func newRateLimitedRouter(store RateLimitStore, rules ...RateLimitRule) *gin.Engine {
+	r := gin.New()
+	// Вместо аутентификации по ключу id ключа берётся из заголовка
+	r.Use(func(c *gin.Context) {
+		if keyID := c.GetHeader("X-Test-Key-ID"); keyID != "" {
+			c.Set(APIKeyIDKey, keyID)
+		}
+	})
+	r.Use(RateLimiterMiddleware(store, zap.NewNop(), rules...))
+	r.Any("/*path", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	return r
+}
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Токены почти не пополняются за время теста: один токен появляется раз в 100 секунд
+	rules := []RateLimitRule{
+		{
+			Name:        "writes",
+			Prefix:      "/api/v1/items",
+			Methods:     []string{http.MethodPost},
+			Limit:       RateLimit{Rate: 0.01, Burst: 1},
+			APIKeyLimit: RateLimit{Rate: 0.01, Burst: 2},
+		},
+		{
+			Name:   "api",
+			Prefix: "/api/",
+			Limit:  RateLimit{Rate: 0.01, Burst: 2},
+		},
+	}
+	router := newRateLimitedRouter(NewMemoryRateLimitStore(), rules...)
+
+	steps := []struct {
+		name   string
+		method string
+		path   string
+		ip     string
+		keyID  string
+		status int
+		// Ожидаемые заголовки; пустое значение - заголовка быть не должно
+		limit      string
+		remaining  string
+		reset      string
+		policy     string
+		retryAfter string
+	}{
+		{"first request", http.MethodGet, "/api/v1/items", "10.0.0.1", "", http.StatusNoContent, "2", "1", "100", "2;w=200", ""},
+		{"last token", http.MethodGet, "/api/v1/items", "10.0.0.1", "", http.StatusNoContent, "2", "0", "200", "2;w=200", ""},
+		{"limited", http.MethodGet, "/api/v1/items/1", "10.0.0.1", "", http.StatusTooManyRequests, "2", "0", "200", "2;w=200", "100"},
+		{"other ip", http.MethodGet, "/api/v1/items", "10.0.0.2", "", http.StatusNoContent, "2", "1", "100", "2;w=200", ""},
+		{"method rule has its own bucket", http.MethodPost, "/api/v1/items", "10.0.0.2", "", http.StatusNoContent, "1", "0", "100", "1;w=100", ""},
+		{"method rule limited", http.MethodPost, "/api/v1/items", "10.0.0.2", "", http.StatusTooManyRequests, "1", "0", "100", "1;w=100", "100"},
+		{"api key bucket and limit", http.MethodPost, "/api/v1/items", "10.0.0.2", "key-1", http.StatusNoContent, "2", "1", "100", "2;w=200", ""},
+		{"other api key", http.MethodPost, "/api/v1/items", "10.0.0.2", "key-2", http.StatusNoContent, "2", "1", "100", "2;w=200", ""},
+		{"api key without own limit uses ip limit", http.MethodGet, "/api/v1/items", "10.0.0.1", "key-1", http.StatusNoContent, "2", "1", "100", "2;w=200", ""},
+		{"no matching rule", http.MethodGet, "/health", "10.0.0.1", "", http.StatusNoContent, "", "", "", "", ""},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			req := httptest.NewRequest(step.method, step.path, nil)
+			req.RemoteAddr = step.ip + ":1234"
+			if step.keyID != "" {
+				req.Header.Set("X-Test-Key-ID", step.keyID)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != step.status {
+				t.Fatalf("status = %d, want %d", w.Code, step.status)
+			}
+			headers := map[string]string{
+				"RateLimit-Limit":     step.limit,
+				"RateLimit-Remaining": step.remaining,
+				"RateLimit-Reset":     step.reset,
+				"RateLimit-Policy":    step.policy,
+				"Retry-After":         step.retryAfter,
+			}
+			for name, want := range headers {
+				if got := w.Header().Get(name); got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+			if step.status == http.StatusTooManyRequests {
+				if ct := w.Header().Get("Content-Type"); ct != ProblemContentType {
+					t.Errorf("Content-Type = %q, want %q", ct, ProblemContentType)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterMiddlewareStoreError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := newRateLimitedRouter(failingRateLimitStore{}, RateLimitRule{Name: "api", Prefix: "/", Limit: RateLimit{Rate: 1, Burst: 1}})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/items", nil))
+	if w.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d: store errors must not block requests", w.Code, http.StatusNoContent)
+	}
+}
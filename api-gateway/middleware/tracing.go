@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"net/http"
+)
+
+// TracingMiddleware начинает спан на каждый запрос и продолжает трассировку,
+// если клиент прислал заголовок traceparent. Служебные /health и /metrics не трассируются.
+// Должен стоять до остальных middleware, чтобы их работа попадала в спан запроса.
+func TracingMiddleware(serviceName string) gin.HandlerFunc {
+	return otelgin.Middleware(serviceName,
+		otelgin.WithFilter(func(r *http.Request) bool {
+			return r.URL.Path != "/health" && r.URL.Path != "/metrics"
+		}),
+	)
+}
@@ -2,14 +2,28 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"fmt"
+	"github.com/XSAM/otelsql"
 	"github.com/gin-gonic/gin"
 	_ "github.com/lib/pq"
+	"github.com/nats-io/nats.go"
 	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
 	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	_ "github.com/neokofg/go-pet-microservices/catalog-service/ent/runtime"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/interceptor"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/logging"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/outbox"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/purger"
 	"github.com/neokofg/go-pet-microservices/catalog-service/internal/service"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/tracing"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/watch"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc/filters"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
@@ -18,6 +32,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 )
@@ -26,20 +41,118 @@ func main() {
 	logger, _ := zap.NewProduction()
 	defer logger.Sync()
 
-	client, err := ent.Open(dialect.Postgres, os.Getenv("DATABASE_URL"))
+	shutdownTracing, err := tracing.Init(context.Background(), "catalog-service")
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error("Failed to shutdown tracing", zap.Error(err))
+		}
+	}()
+
+	// otelsql оборачивает драйвер, чтобы каждый SQL запрос ent попадал в трассировку
+	db, err := otelsql.Open("postgres", os.Getenv("DATABASE_URL"),
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	defer client.Close()
 
 	if err := client.Schema.Create(context.Background()); err != nil {
 		logger.Fatal("Failed to create schema", zap.Error(err))
 	}
 
-	catalogService := service.NewCatalogService(client, logger)
+	if err := service.CreateSearchIndex(context.Background(), client); err != nil {
+		logger.Fatal("Failed to create search index", zap.Error(err))
+	}
+
+	if err := service.SyncTagRegistry(context.Background(), client); err != nil {
+		logger.Fatal("Failed to sync tag registry", zap.Error(err))
+	}
+
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go purger.New(
+		client,
+		durationFromEnv(logger, "SOFT_DELETE_RETENTION", 30*24*time.Hour),
+		durationFromEnv(logger, "PURGE_INTERVAL", time.Hour),
+		logger,
+	).Run(purgeCtx)
 
-	grpcServer := grpc.NewServer()
+	publisher, err := newOutboxPublisher(context.Background())
+	if err != nil {
+		logger.Fatal("Failed to create outbox publisher", zap.Error(err))
+	}
+	defer publisher.Close()
+
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	go outbox.NewRelay(
+		client,
+		publisher,
+		durationFromEnv(logger, "OUTBOX_POLL_INTERVAL", time.Second),
+		outboxBatchSize,
+		durationFromEnv(logger, "OUTBOX_RETENTION", 7*24*time.Hour),
+		logger,
+	).Run(relayCtx)
+
+	// Hub останавливается до GracefulStop: иначе открытые WatchItems потоки не дадут серверу завершиться
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+	hub := watch.New(
+		client,
+		durationFromEnv(logger, "WATCH_POLL_INTERVAL", 500*time.Millisecond),
+		durationFromEnv(logger, "CHANGE_FEED_RETENTION", 24*time.Hour),
+		logger,
+	)
+	go hub.Run(watchCtx)
+
+	catalogService := service.NewCatalogService(client, logger, cursorSecret(logger), hub)
+
+	apiKeyService := service.NewAPIKeyService(client, logger)
+
+	grpcServer := grpc.NewServer(
+		// Пакетные RPC принимают до 1000 элементов, стандартных 4 МБ на сообщение для них мало
+		grpc.MaxRecvMsgSize(maxGRPCMessageSize),
+		grpc.StatsHandler(otelgrpc.NewServerHandler(
+			otelgrpc.WithFilter(filters.Not(filters.HealthCheck())),
+		)),
+		// Порядок важен: логгер запроса нужен всем следующим звеньям, а recovery стоит
+		// после метрик и access log, чтобы паника учитывалась в них как codes.Internal
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(logger),
+			interceptor.UnaryMetrics(),
+			interceptor.UnaryAccessLog(logger),
+			interceptor.UnaryRecovery(logger),
+			interceptor.UnaryDeadline(
+				durationFromEnv(logger, "GRPC_DEFAULT_TIMEOUT", 10*time.Second),
+				durationFromEnv(logger, "GRPC_MAX_TIMEOUT", 30*time.Second),
+			),
+		),
+		grpc.ChainStreamInterceptor(
+			logging.StreamServerInterceptor(logger),
+			interceptor.StreamMetrics(),
+			interceptor.StreamAccessLog(logger),
+			interceptor.StreamRecovery(logger),
+			// Импорт и выгрузка в шлюзе ограничены 10 минутами, WatchItems - бессрочная подписка
+			interceptor.StreamDeadline(
+				durationFromEnv(logger, "GRPC_STREAM_DEFAULT_TIMEOUT", 10*time.Minute),
+				durationFromEnv(logger, "GRPC_STREAM_MAX_TIMEOUT", 15*time.Minute),
+				proto.CatalogService_WatchItems_FullMethodName,
+			),
+		),
+	)
 	proto.RegisterCatalogServiceServer(grpcServer, catalogService)
+	proto.RegisterAPIKeyServiceServer(grpcServer, apiKeyService)
 
 	healthServer := health.NewServer()
 	healthpb.RegisterHealthServer(grpcServer, healthServer)
@@ -91,7 +204,76 @@ func main() {
 		logger.Fatal("HTTP server forced to shutdown", zap.Error(err))
 	}
 
+	stopWatch()
 	grpcServer.GracefulStop()
 
 	logger.Info("Servers exited properly")
 }
+
+const (
+	outboxBatchSize = 100
+	// memoryPublisherCapacity ограничивает память in-memory publisher при долгой работе
+	memoryPublisherCapacity = 10000
+	maxGRPCMessageSize      = 16 << 20
+)
+
+// newOutboxPublisher создаёт publisher по OUTBOX_PUBLISHER: memory (по умолчанию), nats или kafka
+func newOutboxPublisher(ctx context.Context) (outbox.Publisher, error) {
+	switch kind := os.Getenv("OUTBOX_PUBLISHER"); kind {
+	case "", "memory":
+		return outbox.NewMemoryPublisher(memoryPublisherCapacity), nil
+	case "nats":
+		return outbox.NewNATSPublisher(ctx,
+			envOrDefault("NATS_URL", nats.DefaultURL),
+			envOrDefault("NATS_STREAM", "CATALOG"),
+			envOrDefault("NATS_SUBJECT_PREFIX", "catalog"),
+		)
+	case "kafka":
+		brokers := os.Getenv("KAFKA_BROKERS")
+		if brokers == "" {
+			return nil, fmt.Errorf("KAFKA_BROKERS is required for the kafka publisher")
+		}
+		return outbox.NewKafkaPublisher(
+			strings.Split(brokers, ","),
+			envOrDefault("KAFKA_TOPIC", "catalog.items"),
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown OUTBOX_PUBLISHER %q", kind)
+	}
+}
+
+func envOrDefault(name, def string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return def
+}
+
+// cursorSecret возвращает ключ подписи курсоров пагинации.
+// Без PAGE_TOKEN_SECRET генерируется случайный ключ, и курсоры перестают работать после рестарта.
+func cursorSecret(logger *zap.Logger) []byte {
+	if secret := os.Getenv("PAGE_TOKEN_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+
+	logger.Warn("PAGE_TOKEN_SECRET is not set, using a random secret for page cursors")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		logger.Fatal("Failed to generate page cursor secret", zap.Error(err))
+	}
+	return secret
+}
+
+// durationFromEnv читает длительность в формате time.ParseDuration, например "10s"
+func durationFromEnv(logger *zap.Logger, name string, def time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return def
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		logger.Fatal("Invalid duration", zap.String("name", name), zap.String("value", value))
+	}
+	return d
+}
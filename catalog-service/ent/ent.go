@@ -12,7 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/apikey"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/category"
 	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemchange"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemrevision"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/outboxevent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/review"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			item.Table: item.ValidColumn,
+			apikey.Table:       apikey.ValidColumn,
+			category.Table:     category.ValidColumn,
+			item.Table:         item.ValidColumn,
+			itemchange.Table:   itemchange.ValidColumn,
+			itemrevision.Table: itemrevision.ValidColumn,
+			outboxevent.Table:  outboxevent.ValidColumn,
+			review.Table:       review.ValidColumn,
+			tag.Table:          tag.ValidColumn,
+			tagsynonym.Table:   tagsynonym.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
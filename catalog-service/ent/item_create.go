@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/category"
 	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/review"
 )
 
 // ItemCreate is the builder for creating a Item entity.
@@ -18,6 +22,35 @@ type ItemCreate struct {
 	config
 	mutation *ItemMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *ItemCreate) SetDeletedAt(t time.Time) *ItemCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableDeletedAt(t *time.Time) *ItemCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
+// SetExternalID sets the "external_id" field.
+func (ic *ItemCreate) SetExternalID(s string) *ItemCreate {
+	ic.mutation.SetExternalID(s)
+	return ic
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableExternalID(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetExternalID(*s)
+	}
+	return ic
 }
 
 // SetTitle sets the "title" field.
@@ -88,6 +121,20 @@ func (ic *ItemCreate) SetNillableReviewCount(i *int) *ItemCreate {
 	return ic
 }
 
+// SetVersion sets the "version" field.
+func (ic *ItemCreate) SetVersion(i int64) *ItemCreate {
+	ic.mutation.SetVersion(i)
+	return ic
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableVersion(i *int64) *ItemCreate {
+	if i != nil {
+		ic.SetVersion(*i)
+	}
+	return ic
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ic *ItemCreate) SetCreatedAt(t time.Time) *ItemCreate {
 	ic.mutation.SetCreatedAt(t)
@@ -130,6 +177,36 @@ func (ic *ItemCreate) SetNillableID(s *string) *ItemCreate {
 	return ic
 }
 
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (ic *ItemCreate) AddReviewIDs(ids ...string) *ItemCreate {
+	ic.mutation.AddReviewIDs(ids...)
+	return ic
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (ic *ItemCreate) AddReviews(r ...*Review) *ItemCreate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ic.AddReviewIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (ic *ItemCreate) AddCategoryIDs(ids ...string) *ItemCreate {
+	ic.mutation.AddCategoryIDs(ids...)
+	return ic
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (ic *ItemCreate) AddCategories(c ...*Category) *ItemCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ic.AddCategoryIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (ic *ItemCreate) Mutation() *ItemMutation {
 	return ic.mutation
@@ -137,7 +214,9 @@ func (ic *ItemCreate) Mutation() *ItemMutation {
 
 // Save creates the Item in the database.
 func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
-	ic.defaults()
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
 }
 
@@ -164,7 +243,7 @@ func (ic *ItemCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ic *ItemCreate) defaults() {
+func (ic *ItemCreate) defaults() error {
 	if _, ok := ic.mutation.Rating(); !ok {
 		v := item.DefaultRating
 		ic.mutation.SetRating(v)
@@ -173,18 +252,32 @@ func (ic *ItemCreate) defaults() {
 		v := item.DefaultReviewCount
 		ic.mutation.SetReviewCount(v)
 	}
+	if _, ok := ic.mutation.Version(); !ok {
+		v := item.DefaultVersion
+		ic.mutation.SetVersion(v)
+	}
 	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if item.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultCreatedAt()
 		ic.mutation.SetCreatedAt(v)
 	}
 	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		if item.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultUpdatedAt()
 		ic.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := ic.mutation.ID(); !ok {
+		if item.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultID()
 		ic.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -203,6 +296,9 @@ func (ic *ItemCreate) check() error {
 	if _, ok := ic.mutation.ReviewCount(); !ok {
 		return &ValidationError{Name: "review_count", err: errors.New(`ent: missing required field "Item.review_count"`)}
 	}
+	if _, ok := ic.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Item.version"`)}
+	}
 	if _, ok := ic.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Item.created_at"`)}
 	}
@@ -240,10 +336,19 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_node = &Item{config: ic.config}
 		_spec = sqlgraph.NewCreateSpec(item.Table, sqlgraph.NewFieldSpec(item.FieldID, field.TypeString))
 	)
+	_spec.OnConflict = ic.conflict
 	if id, ok := ic.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := ic.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
 	if value, ok := ic.mutation.Title(); ok {
 		_spec.SetField(item.FieldTitle, field.TypeString, value)
 		_node.Title = value
@@ -268,6 +373,10 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldReviewCount, field.TypeInt, value)
 		_node.ReviewCount = value
 	}
+	if value, ok := ic.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
 	if value, ok := ic.mutation.CreatedAt(); ok {
 		_spec.SetField(item.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -276,14 +385,572 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if nodes := ic.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ReviewsTable,
+			Columns: []string{item.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Item.Create().
+//		SetDeletedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemUpsert) {
+//			SetDeletedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *ItemCreate) OnConflict(opts ...sql.ConflictOption) *ItemUpsertOne {
+	ic.conflict = opts
+	return &ItemUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *ItemCreate) OnConflictColumns(columns ...string) *ItemUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &ItemUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// ItemUpsertOne is the builder for "upsert"-ing
+	//  one Item node.
+	ItemUpsertOne struct {
+		create *ItemCreate
+	}
+
+	// ItemUpsert is the "OnConflict" setter.
+	ItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ItemUpsert) SetDeletedAt(v time.Time) *ItemUpsert {
+	u.Set(item.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateDeletedAt() *ItemUpsert {
+	u.SetExcluded(item.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ItemUpsert) ClearDeletedAt() *ItemUpsert {
+	u.SetNull(item.FieldDeletedAt)
+	return u
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsert) SetExternalID(v string) *ItemUpsert {
+	u.Set(item.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateExternalID() *ItemUpsert {
+	u.SetExcluded(item.FieldExternalID)
+	return u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *ItemUpsert) ClearExternalID() *ItemUpsert {
+	u.SetNull(item.FieldExternalID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ItemUpsert) SetTitle(v string) *ItemUpsert {
+	u.Set(item.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateTitle() *ItemUpsert {
+	u.SetExcluded(item.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ItemUpsert) SetDescription(v string) *ItemUpsert {
+	u.Set(item.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateDescription() *ItemUpsert {
+	u.SetExcluded(item.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ItemUpsert) ClearDescription() *ItemUpsert {
+	u.SetNull(item.FieldDescription)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *ItemUpsert) SetTags(v []string) *ItemUpsert {
+	u.Set(item.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateTags() *ItemUpsert {
+	u.SetExcluded(item.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *ItemUpsert) ClearTags() *ItemUpsert {
+	u.SetNull(item.FieldTags)
+	return u
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *ItemUpsert) SetImageURL(v string) *ItemUpsert {
+	u.Set(item.FieldImageURL, v)
+	return u
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateImageURL() *ItemUpsert {
+	u.SetExcluded(item.FieldImageURL)
+	return u
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (u *ItemUpsert) ClearImageURL() *ItemUpsert {
+	u.SetNull(item.FieldImageURL)
+	return u
+}
+
+// SetRating sets the "rating" field.
+func (u *ItemUpsert) SetRating(v float64) *ItemUpsert {
+	u.Set(item.FieldRating, v)
+	return u
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateRating() *ItemUpsert {
+	u.SetExcluded(item.FieldRating)
+	return u
+}
+
+// AddRating adds v to the "rating" field.
+func (u *ItemUpsert) AddRating(v float64) *ItemUpsert {
+	u.Add(item.FieldRating, v)
+	return u
+}
+
+// SetReviewCount sets the "review_count" field.
+func (u *ItemUpsert) SetReviewCount(v int) *ItemUpsert {
+	u.Set(item.FieldReviewCount, v)
+	return u
+}
+
+// UpdateReviewCount sets the "review_count" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateReviewCount() *ItemUpsert {
+	u.SetExcluded(item.FieldReviewCount)
+	return u
+}
+
+// AddReviewCount adds v to the "review_count" field.
+func (u *ItemUpsert) AddReviewCount(v int) *ItemUpsert {
+	u.Add(item.FieldReviewCount, v)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ItemUpsert) SetVersion(v int64) *ItemUpsert {
+	u.Set(item.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateVersion() *ItemUpsert {
+	u.SetExcluded(item.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ItemUpsert) AddVersion(v int64) *ItemUpsert {
+	u.Add(item.FieldVersion, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ItemUpsert) SetCreatedAt(v time.Time) *ItemUpsert {
+	u.Set(item.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateCreatedAt() *ItemUpsert {
+	u.SetExcluded(item.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemUpsert) SetUpdatedAt(v time.Time) *ItemUpsert {
+	u.Set(item.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateUpdatedAt() *ItemUpsert {
+	u.SetExcluded(item.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(item.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemUpsertOne) UpdateNewValues() *ItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(item.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ItemUpsertOne) Ignore() *ItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemUpsertOne) DoNothing() *ItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemCreate.OnConflict
+// documentation for more info.
+func (u *ItemUpsertOne) Update(set func(*ItemUpsert)) *ItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ItemUpsertOne) SetDeletedAt(v time.Time) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateDeletedAt() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ItemUpsertOne) ClearDeletedAt() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsertOne) SetExternalID(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateExternalID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *ItemUpsertOne) ClearExternalID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearExternalID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ItemUpsertOne) SetTitle(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateTitle() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ItemUpsertOne) SetDescription(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateDescription() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ItemUpsertOne) ClearDescription() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *ItemUpsertOne) SetTags(v []string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateTags() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *ItemUpsertOne) ClearTags() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *ItemUpsertOne) SetImageURL(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetImageURL(v)
+	})
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateImageURL() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateImageURL()
+	})
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (u *ItemUpsertOne) ClearImageURL() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearImageURL()
+	})
+}
+
+// SetRating sets the "rating" field.
+func (u *ItemUpsertOne) SetRating(v float64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetRating(v)
+	})
+}
+
+// AddRating adds v to the "rating" field.
+func (u *ItemUpsertOne) AddRating(v float64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddRating(v)
+	})
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateRating() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateRating()
+	})
+}
+
+// SetReviewCount sets the "review_count" field.
+func (u *ItemUpsertOne) SetReviewCount(v int) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetReviewCount(v)
+	})
+}
+
+// AddReviewCount adds v to the "review_count" field.
+func (u *ItemUpsertOne) AddReviewCount(v int) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddReviewCount(v)
+	})
+}
+
+// UpdateReviewCount sets the "review_count" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateReviewCount() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateReviewCount()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ItemUpsertOne) SetVersion(v int64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ItemUpsertOne) AddVersion(v int64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateVersion() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ItemUpsertOne) SetCreatedAt(v time.Time) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateCreatedAt() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemUpsertOne) SetUpdatedAt(v time.Time) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateUpdatedAt() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ItemUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ItemUpsertOne.ID is not supported by MySQL driver. Use ItemUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ItemUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ItemCreateBulk is the builder for creating many Item entities in bulk.
 type ItemCreateBulk struct {
 	config
 	err      error
 	builders []*ItemCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Item entities in the database.
@@ -313,6 +980,7 @@ func (icb *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
 					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -362,3 +1030,327 @@ func (icb *ItemCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Item.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemUpsert) {
+//			SetDeletedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *ItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *ItemUpsertBulk {
+	icb.conflict = opts
+	return &ItemUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *ItemCreateBulk) OnConflictColumns(columns ...string) *ItemUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &ItemUpsertBulk{
+		create: icb,
+	}
+}
+
+// ItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of Item nodes.
+type ItemUpsertBulk struct {
+	create *ItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(item.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemUpsertBulk) UpdateNewValues() *ItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(item.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ItemUpsertBulk) Ignore() *ItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemUpsertBulk) DoNothing() *ItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *ItemUpsertBulk) Update(set func(*ItemUpsert)) *ItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ItemUpsertBulk) SetDeletedAt(v time.Time) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateDeletedAt() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ItemUpsertBulk) ClearDeletedAt() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsertBulk) SetExternalID(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateExternalID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *ItemUpsertBulk) ClearExternalID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearExternalID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ItemUpsertBulk) SetTitle(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateTitle() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ItemUpsertBulk) SetDescription(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateDescription() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ItemUpsertBulk) ClearDescription() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *ItemUpsertBulk) SetTags(v []string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateTags() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *ItemUpsertBulk) ClearTags() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetImageURL sets the "image_url" field.
+func (u *ItemUpsertBulk) SetImageURL(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetImageURL(v)
+	})
+}
+
+// UpdateImageURL sets the "image_url" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateImageURL() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateImageURL()
+	})
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (u *ItemUpsertBulk) ClearImageURL() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearImageURL()
+	})
+}
+
+// SetRating sets the "rating" field.
+func (u *ItemUpsertBulk) SetRating(v float64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetRating(v)
+	})
+}
+
+// AddRating adds v to the "rating" field.
+func (u *ItemUpsertBulk) AddRating(v float64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddRating(v)
+	})
+}
+
+// UpdateRating sets the "rating" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateRating() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateRating()
+	})
+}
+
+// SetReviewCount sets the "review_count" field.
+func (u *ItemUpsertBulk) SetReviewCount(v int) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetReviewCount(v)
+	})
+}
+
+// AddReviewCount adds v to the "review_count" field.
+func (u *ItemUpsertBulk) AddReviewCount(v int) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddReviewCount(v)
+	})
+}
+
+// UpdateReviewCount sets the "review_count" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateReviewCount() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateReviewCount()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ItemUpsertBulk) SetVersion(v int64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ItemUpsertBulk) AddVersion(v int64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateVersion() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ItemUpsertBulk) SetCreatedAt(v time.Time) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateCreatedAt() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ItemUpsertBulk) SetUpdatedAt(v time.Time) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateUpdatedAt() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/category"
 	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
 	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/review"
 )
 
 // ItemUpdate is the builder for updating Item entities.
@@ -29,6 +31,46 @@ func (iu *ItemUpdate) Where(ps ...predicate.Item) *ItemUpdate {
 	return iu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *ItemUpdate) SetDeletedAt(t time.Time) *ItemUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableDeletedAt(t *time.Time) *ItemUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *ItemUpdate) ClearDeletedAt() *ItemUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
+// SetExternalID sets the "external_id" field.
+func (iu *ItemUpdate) SetExternalID(s string) *ItemUpdate {
+	iu.mutation.SetExternalID(s)
+	return iu
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableExternalID(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetExternalID(*s)
+	}
+	return iu
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (iu *ItemUpdate) ClearExternalID() *ItemUpdate {
+	iu.mutation.ClearExternalID()
+	return iu
+}
+
 // SetTitle sets the "title" field.
 func (iu *ItemUpdate) SetTitle(s string) *ItemUpdate {
 	iu.mutation.SetTitle(s)
@@ -143,6 +185,27 @@ func (iu *ItemUpdate) AddReviewCount(i int) *ItemUpdate {
 	return iu
 }
 
+// SetVersion sets the "version" field.
+func (iu *ItemUpdate) SetVersion(i int64) *ItemUpdate {
+	iu.mutation.ResetVersion()
+	iu.mutation.SetVersion(i)
+	return iu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableVersion(i *int64) *ItemUpdate {
+	if i != nil {
+		iu.SetVersion(*i)
+	}
+	return iu
+}
+
+// AddVersion adds i to the "version" field.
+func (iu *ItemUpdate) AddVersion(i int64) *ItemUpdate {
+	iu.mutation.AddVersion(i)
+	return iu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (iu *ItemUpdate) SetCreatedAt(t time.Time) *ItemUpdate {
 	iu.mutation.SetCreatedAt(t)
@@ -163,14 +226,88 @@ func (iu *ItemUpdate) SetUpdatedAt(t time.Time) *ItemUpdate {
 	return iu
 }
 
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (iu *ItemUpdate) AddReviewIDs(ids ...string) *ItemUpdate {
+	iu.mutation.AddReviewIDs(ids...)
+	return iu
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (iu *ItemUpdate) AddReviews(r ...*Review) *ItemUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return iu.AddReviewIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (iu *ItemUpdate) AddCategoryIDs(ids ...string) *ItemUpdate {
+	iu.mutation.AddCategoryIDs(ids...)
+	return iu
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (iu *ItemUpdate) AddCategories(c ...*Category) *ItemUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iu.AddCategoryIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iu *ItemUpdate) Mutation() *ItemMutation {
 	return iu.mutation
 }
 
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (iu *ItemUpdate) ClearReviews() *ItemUpdate {
+	iu.mutation.ClearReviews()
+	return iu
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (iu *ItemUpdate) RemoveReviewIDs(ids ...string) *ItemUpdate {
+	iu.mutation.RemoveReviewIDs(ids...)
+	return iu
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (iu *ItemUpdate) RemoveReviews(r ...*Review) *ItemUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return iu.RemoveReviewIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (iu *ItemUpdate) ClearCategories() *ItemUpdate {
+	iu.mutation.ClearCategories()
+	return iu
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (iu *ItemUpdate) RemoveCategoryIDs(ids ...string) *ItemUpdate {
+	iu.mutation.RemoveCategoryIDs(ids...)
+	return iu
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (iu *ItemUpdate) RemoveCategories(c ...*Category) *ItemUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iu.RemoveCategoryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (iu *ItemUpdate) Save(ctx context.Context) (int, error) {
-	iu.defaults()
+	if err := iu.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
 }
 
@@ -197,11 +334,15 @@ func (iu *ItemUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (iu *ItemUpdate) defaults() {
+func (iu *ItemUpdate) defaults() error {
 	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		if item.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.UpdateDefaultUpdatedAt()
 		iu.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -226,6 +367,18 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.ClearField(item.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
+	}
+	if iu.mutation.ExternalIDCleared() {
+		_spec.ClearField(item.FieldExternalID, field.TypeString)
+	}
 	if value, ok := iu.mutation.Title(); ok {
 		_spec.SetField(item.FieldTitle, field.TypeString, value)
 	}
@@ -264,12 +417,108 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := iu.mutation.AddedReviewCount(); ok {
 		_spec.AddField(item.FieldReviewCount, field.TypeInt, value)
 	}
+	if value, ok := iu.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedVersion(); ok {
+		_spec.AddField(item.FieldVersion, field.TypeInt64, value)
+	}
 	if value, ok := iu.mutation.CreatedAt(); ok {
 		_spec.SetField(item.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := iu.mutation.UpdatedAt(); ok {
 		_spec.SetField(item.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if iu.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ReviewsTable,
+			Columns: []string{item.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !iu.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ReviewsTable,
+			Columns: []string{item.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ReviewsTable,
+			Columns: []string{item.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !iu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{item.Label}
@@ -290,6 +539,46 @@ type ItemUpdateOne struct {
 	mutation *ItemMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *ItemUpdateOne) SetDeletedAt(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableDeletedAt(t *time.Time) *ItemUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *ItemUpdateOne) ClearDeletedAt() *ItemUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
+}
+
+// SetExternalID sets the "external_id" field.
+func (iuo *ItemUpdateOne) SetExternalID(s string) *ItemUpdateOne {
+	iuo.mutation.SetExternalID(s)
+	return iuo
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableExternalID(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetExternalID(*s)
+	}
+	return iuo
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (iuo *ItemUpdateOne) ClearExternalID() *ItemUpdateOne {
+	iuo.mutation.ClearExternalID()
+	return iuo
+}
+
 // SetTitle sets the "title" field.
 func (iuo *ItemUpdateOne) SetTitle(s string) *ItemUpdateOne {
 	iuo.mutation.SetTitle(s)
@@ -404,6 +693,27 @@ func (iuo *ItemUpdateOne) AddReviewCount(i int) *ItemUpdateOne {
 	return iuo
 }
 
+// SetVersion sets the "version" field.
+func (iuo *ItemUpdateOne) SetVersion(i int64) *ItemUpdateOne {
+	iuo.mutation.ResetVersion()
+	iuo.mutation.SetVersion(i)
+	return iuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableVersion(i *int64) *ItemUpdateOne {
+	if i != nil {
+		iuo.SetVersion(*i)
+	}
+	return iuo
+}
+
+// AddVersion adds i to the "version" field.
+func (iuo *ItemUpdateOne) AddVersion(i int64) *ItemUpdateOne {
+	iuo.mutation.AddVersion(i)
+	return iuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (iuo *ItemUpdateOne) SetCreatedAt(t time.Time) *ItemUpdateOne {
 	iuo.mutation.SetCreatedAt(t)
@@ -424,11 +734,83 @@ func (iuo *ItemUpdateOne) SetUpdatedAt(t time.Time) *ItemUpdateOne {
 	return iuo
 }
 
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (iuo *ItemUpdateOne) AddReviewIDs(ids ...string) *ItemUpdateOne {
+	iuo.mutation.AddReviewIDs(ids...)
+	return iuo
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (iuo *ItemUpdateOne) AddReviews(r ...*Review) *ItemUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return iuo.AddReviewIDs(ids...)
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (iuo *ItemUpdateOne) AddCategoryIDs(ids ...string) *ItemUpdateOne {
+	iuo.mutation.AddCategoryIDs(ids...)
+	return iuo
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (iuo *ItemUpdateOne) AddCategories(c ...*Category) *ItemUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iuo.AddCategoryIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (iuo *ItemUpdateOne) Mutation() *ItemMutation {
 	return iuo.mutation
 }
 
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (iuo *ItemUpdateOne) ClearReviews() *ItemUpdateOne {
+	iuo.mutation.ClearReviews()
+	return iuo
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (iuo *ItemUpdateOne) RemoveReviewIDs(ids ...string) *ItemUpdateOne {
+	iuo.mutation.RemoveReviewIDs(ids...)
+	return iuo
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (iuo *ItemUpdateOne) RemoveReviews(r ...*Review) *ItemUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return iuo.RemoveReviewIDs(ids...)
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (iuo *ItemUpdateOne) ClearCategories() *ItemUpdateOne {
+	iuo.mutation.ClearCategories()
+	return iuo
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (iuo *ItemUpdateOne) RemoveCategoryIDs(ids ...string) *ItemUpdateOne {
+	iuo.mutation.RemoveCategoryIDs(ids...)
+	return iuo
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (iuo *ItemUpdateOne) RemoveCategories(c ...*Category) *ItemUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iuo.RemoveCategoryIDs(ids...)
+}
+
 // Where appends a list predicates to the ItemUpdate builder.
 func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
 	iuo.mutation.Where(ps...)
@@ -444,7 +826,9 @@ func (iuo *ItemUpdateOne) Select(field string, fields ...string) *ItemUpdateOne
 
 // Save executes the query and returns the updated Item entity.
 func (iuo *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
-	iuo.defaults()
+	if err := iuo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
 }
 
@@ -471,11 +855,15 @@ func (iuo *ItemUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (iuo *ItemUpdateOne) defaults() {
+func (iuo *ItemUpdateOne) defaults() error {
 	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		if item.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.UpdateDefaultUpdatedAt()
 		iuo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -517,6 +905,18 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 			}
 		}
 	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.SetField(item.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(item.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
+	}
+	if iuo.mutation.ExternalIDCleared() {
+		_spec.ClearField(item.FieldExternalID, field.TypeString)
+	}
 	if value, ok := iuo.mutation.Title(); ok {
 		_spec.SetField(item.FieldTitle, field.TypeString, value)
 	}
@@ -555,12 +955,108 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if value, ok := iuo.mutation.AddedReviewCount(); ok {
 		_spec.AddField(item.FieldReviewCount, field.TypeInt, value)
 	}
+	if value, ok := iuo.mutation.Version(); ok {
+		_spec.SetField(item.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedVersion(); ok {
+		_spec.AddField(item.FieldVersion, field.TypeInt64, value)
+	}
 	if value, ok := iuo.mutation.CreatedAt(); ok {
 		_spec.SetField(item.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := iuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(item.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if iuo.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ReviewsTable,
+			Columns: []string{item.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !iuo.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ReviewsTable,
+			Columns: []string{item.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ReviewsTable,
+			Columns: []string{item.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !iuo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.CategoriesTable,
+			Columns: item.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Item{config: iuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemchange"
+)
+
+// ItemChangeCreate is the builder for creating a ItemChange entity.
+type ItemChangeCreate struct {
+	config
+	mutation *ItemChangeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEventID sets the "event_id" field.
+func (icc *ItemChangeCreate) SetEventID(s string) *ItemChangeCreate {
+	icc.mutation.SetEventID(s)
+	return icc
+}
+
+// SetEventType sets the "event_type" field.
+func (icc *ItemChangeCreate) SetEventType(s string) *ItemChangeCreate {
+	icc.mutation.SetEventType(s)
+	return icc
+}
+
+// SetItemID sets the "item_id" field.
+func (icc *ItemChangeCreate) SetItemID(s string) *ItemChangeCreate {
+	icc.mutation.SetItemID(s)
+	return icc
+}
+
+// SetTags sets the "tags" field.
+func (icc *ItemChangeCreate) SetTags(s []string) *ItemChangeCreate {
+	icc.mutation.SetTags(s)
+	return icc
+}
+
+// SetPayload sets the "payload" field.
+func (icc *ItemChangeCreate) SetPayload(b []byte) *ItemChangeCreate {
+	icc.mutation.SetPayload(b)
+	return icc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (icc *ItemChangeCreate) SetCreatedAt(t time.Time) *ItemChangeCreate {
+	icc.mutation.SetCreatedAt(t)
+	return icc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (icc *ItemChangeCreate) SetNillableCreatedAt(t *time.Time) *ItemChangeCreate {
+	if t != nil {
+		icc.SetCreatedAt(*t)
+	}
+	return icc
+}
+
+// SetID sets the "id" field.
+func (icc *ItemChangeCreate) SetID(i int64) *ItemChangeCreate {
+	icc.mutation.SetID(i)
+	return icc
+}
+
+// Mutation returns the ItemChangeMutation object of the builder.
+func (icc *ItemChangeCreate) Mutation() *ItemChangeMutation {
+	return icc.mutation
+}
+
+// Save creates the ItemChange in the database.
+func (icc *ItemChangeCreate) Save(ctx context.Context) (*ItemChange, error) {
+	icc.defaults()
+	return withHooks(ctx, icc.sqlSave, icc.mutation, icc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (icc *ItemChangeCreate) SaveX(ctx context.Context) *ItemChange {
+	v, err := icc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icc *ItemChangeCreate) Exec(ctx context.Context) error {
+	_, err := icc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icc *ItemChangeCreate) ExecX(ctx context.Context) {
+	if err := icc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (icc *ItemChangeCreate) defaults() {
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		v := itemchange.DefaultCreatedAt()
+		icc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (icc *ItemChangeCreate) check() error {
+	if _, ok := icc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "ItemChange.event_id"`)}
+	}
+	if _, ok := icc.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "ItemChange.event_type"`)}
+	}
+	if _, ok := icc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemChange.item_id"`)}
+	}
+	if _, ok := icc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "ItemChange.payload"`)}
+	}
+	if _, ok := icc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemChange.created_at"`)}
+	}
+	return nil
+}
+
+func (icc *ItemChangeCreate) sqlSave(ctx context.Context) (*ItemChange, error) {
+	if err := icc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := icc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, icc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	icc.mutation.id = &_node.ID
+	icc.mutation.done = true
+	return _node, nil
+}
+
+func (icc *ItemChangeCreate) createSpec() (*ItemChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemChange{config: icc.config}
+		_spec = sqlgraph.NewCreateSpec(itemchange.Table, sqlgraph.NewFieldSpec(itemchange.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = icc.conflict
+	if id, ok := icc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := icc.mutation.EventID(); ok {
+		_spec.SetField(itemchange.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := icc.mutation.EventType(); ok {
+		_spec.SetField(itemchange.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := icc.mutation.ItemID(); ok {
+		_spec.SetField(itemchange.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := icc.mutation.Tags(); ok {
+		_spec.SetField(itemchange.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := icc.mutation.Payload(); ok {
+		_spec.SetField(itemchange.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := icc.mutation.CreatedAt(); ok {
+		_spec.SetField(itemchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemChange.Create().
+//		SetEventID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemChangeUpsert) {
+//			SetEventID(v+v).
+//		}).
+//		Exec(ctx)
+func (icc *ItemChangeCreate) OnConflict(opts ...sql.ConflictOption) *ItemChangeUpsertOne {
+	icc.conflict = opts
+	return &ItemChangeUpsertOne{
+		create: icc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icc *ItemChangeCreate) OnConflictColumns(columns ...string) *ItemChangeUpsertOne {
+	icc.conflict = append(icc.conflict, sql.ConflictColumns(columns...))
+	return &ItemChangeUpsertOne{
+		create: icc,
+	}
+}
+
+type (
+	// ItemChangeUpsertOne is the builder for "upsert"-ing
+	//  one ItemChange node.
+	ItemChangeUpsertOne struct {
+		create *ItemChangeCreate
+	}
+
+	// ItemChangeUpsert is the "OnConflict" setter.
+	ItemChangeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ItemChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(itemchange.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemChangeUpsertOne) UpdateNewValues() *ItemChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(itemchange.FieldID)
+		}
+		if _, exists := u.create.mutation.EventID(); exists {
+			s.SetIgnore(itemchange.FieldEventID)
+		}
+		if _, exists := u.create.mutation.EventType(); exists {
+			s.SetIgnore(itemchange.FieldEventType)
+		}
+		if _, exists := u.create.mutation.ItemID(); exists {
+			s.SetIgnore(itemchange.FieldItemID)
+		}
+		if _, exists := u.create.mutation.Tags(); exists {
+			s.SetIgnore(itemchange.FieldTags)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(itemchange.FieldPayload)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(itemchange.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemChange.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ItemChangeUpsertOne) Ignore() *ItemChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemChangeUpsertOne) DoNothing() *ItemChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemChangeCreate.OnConflict
+// documentation for more info.
+func (u *ItemChangeUpsertOne) Update(set func(*ItemChangeUpsert)) *ItemChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ItemChangeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItemChangeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemChangeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ItemChangeUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ItemChangeUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ItemChangeCreateBulk is the builder for creating many ItemChange entities in bulk.
+type ItemChangeCreateBulk struct {
+	config
+	err      error
+	builders []*ItemChangeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ItemChange entities in the database.
+func (iccb *ItemChangeCreateBulk) Save(ctx context.Context) ([]*ItemChange, error) {
+	if iccb.err != nil {
+		return nil, iccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iccb.builders))
+	nodes := make([]*ItemChange, len(iccb.builders))
+	mutators := make([]Mutator, len(iccb.builders))
+	for i := range iccb.builders {
+		func(i int, root context.Context) {
+			builder := iccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = iccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iccb *ItemChangeCreateBulk) SaveX(ctx context.Context) []*ItemChange {
+	v, err := iccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iccb *ItemChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := iccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iccb *ItemChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := iccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemChange.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemChangeUpsert) {
+//			SetEventID(v+v).
+//		}).
+//		Exec(ctx)
+func (iccb *ItemChangeCreateBulk) OnConflict(opts ...sql.ConflictOption) *ItemChangeUpsertBulk {
+	iccb.conflict = opts
+	return &ItemChangeUpsertBulk{
+		create: iccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (iccb *ItemChangeCreateBulk) OnConflictColumns(columns ...string) *ItemChangeUpsertBulk {
+	iccb.conflict = append(iccb.conflict, sql.ConflictColumns(columns...))
+	return &ItemChangeUpsertBulk{
+		create: iccb,
+	}
+}
+
+// ItemChangeUpsertBulk is the builder for "upsert"-ing
+// a bulk of ItemChange nodes.
+type ItemChangeUpsertBulk struct {
+	create *ItemChangeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ItemChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(itemchange.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemChangeUpsertBulk) UpdateNewValues() *ItemChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(itemchange.FieldID)
+			}
+			if _, exists := b.mutation.EventID(); exists {
+				s.SetIgnore(itemchange.FieldEventID)
+			}
+			if _, exists := b.mutation.EventType(); exists {
+				s.SetIgnore(itemchange.FieldEventType)
+			}
+			if _, exists := b.mutation.ItemID(); exists {
+				s.SetIgnore(itemchange.FieldItemID)
+			}
+			if _, exists := b.mutation.Tags(); exists {
+				s.SetIgnore(itemchange.FieldTags)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(itemchange.FieldPayload)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(itemchange.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemChange.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ItemChangeUpsertBulk) Ignore() *ItemChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemChangeUpsertBulk) DoNothing() *ItemChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemChangeCreateBulk.OnConflict
+// documentation for more info.
+func (u *ItemChangeUpsertBulk) Update(set func(*ItemChangeUpsert)) *ItemChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ItemChangeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ItemChangeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItemChangeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemChangeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
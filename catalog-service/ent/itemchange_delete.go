@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemchange"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+)
+
+// ItemChangeDelete is the builder for deleting a ItemChange entity.
+type ItemChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemChangeMutation
+}
+
+// Where appends a list predicates to the ItemChangeDelete builder.
+func (icd *ItemChangeDelete) Where(ps ...predicate.ItemChange) *ItemChangeDelete {
+	icd.mutation.Where(ps...)
+	return icd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (icd *ItemChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, icd.sqlExec, icd.mutation, icd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icd *ItemChangeDelete) ExecX(ctx context.Context) int {
+	n, err := icd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (icd *ItemChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemchange.Table, sqlgraph.NewFieldSpec(itemchange.FieldID, field.TypeInt64))
+	if ps := icd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, icd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	icd.mutation.done = true
+	return affected, err
+}
+
+// ItemChangeDeleteOne is the builder for deleting a single ItemChange entity.
+type ItemChangeDeleteOne struct {
+	icd *ItemChangeDelete
+}
+
+// Where appends a list predicates to the ItemChangeDelete builder.
+func (icdo *ItemChangeDeleteOne) Where(ps ...predicate.ItemChange) *ItemChangeDeleteOne {
+	icdo.icd.mutation.Where(ps...)
+	return icdo
+}
+
+// Exec executes the deletion query.
+func (icdo *ItemChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := icdo.icd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemchange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icdo *ItemChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := icdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
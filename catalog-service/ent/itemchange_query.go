@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemchange"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+)
+
+// ItemChangeQuery is the builder for querying ItemChange entities.
+type ItemChangeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemchange.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemChange
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemChangeQuery builder.
+func (icq *ItemChangeQuery) Where(ps ...predicate.ItemChange) *ItemChangeQuery {
+	icq.predicates = append(icq.predicates, ps...)
+	return icq
+}
+
+// Limit the number of records to be returned by this query.
+func (icq *ItemChangeQuery) Limit(limit int) *ItemChangeQuery {
+	icq.ctx.Limit = &limit
+	return icq
+}
+
+// Offset to start from.
+func (icq *ItemChangeQuery) Offset(offset int) *ItemChangeQuery {
+	icq.ctx.Offset = &offset
+	return icq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (icq *ItemChangeQuery) Unique(unique bool) *ItemChangeQuery {
+	icq.ctx.Unique = &unique
+	return icq
+}
+
+// Order specifies how the records should be ordered.
+func (icq *ItemChangeQuery) Order(o ...itemchange.OrderOption) *ItemChangeQuery {
+	icq.order = append(icq.order, o...)
+	return icq
+}
+
+// First returns the first ItemChange entity from the query.
+// Returns a *NotFoundError when no ItemChange was found.
+func (icq *ItemChangeQuery) First(ctx context.Context) (*ItemChange, error) {
+	nodes, err := icq.Limit(1).All(setContextOp(ctx, icq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemchange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (icq *ItemChangeQuery) FirstX(ctx context.Context) *ItemChange {
+	node, err := icq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemChange ID from the query.
+// Returns a *NotFoundError when no ItemChange ID was found.
+func (icq *ItemChangeQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = icq.Limit(1).IDs(setContextOp(ctx, icq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemchange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (icq *ItemChangeQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := icq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemChange entity is found.
+// Returns a *NotFoundError when no ItemChange entities are found.
+func (icq *ItemChangeQuery) Only(ctx context.Context) (*ItemChange, error) {
+	nodes, err := icq.Limit(2).All(setContextOp(ctx, icq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemchange.Label}
+	default:
+		return nil, &NotSingularError{itemchange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (icq *ItemChangeQuery) OnlyX(ctx context.Context) *ItemChange {
+	node, err := icq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemChange ID in the query.
+// Returns a *NotSingularError when more than one ItemChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (icq *ItemChangeQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = icq.Limit(2).IDs(setContextOp(ctx, icq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemchange.Label}
+	default:
+		err = &NotSingularError{itemchange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (icq *ItemChangeQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := icq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemChanges.
+func (icq *ItemChangeQuery) All(ctx context.Context) ([]*ItemChange, error) {
+	ctx = setContextOp(ctx, icq.ctx, ent.OpQueryAll)
+	if err := icq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemChange, *ItemChangeQuery]()
+	return withInterceptors[[]*ItemChange](ctx, icq, qr, icq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (icq *ItemChangeQuery) AllX(ctx context.Context) []*ItemChange {
+	nodes, err := icq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemChange IDs.
+func (icq *ItemChangeQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if icq.ctx.Unique == nil && icq.path != nil {
+		icq.Unique(true)
+	}
+	ctx = setContextOp(ctx, icq.ctx, ent.OpQueryIDs)
+	if err = icq.Select(itemchange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (icq *ItemChangeQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := icq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (icq *ItemChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, icq.ctx, ent.OpQueryCount)
+	if err := icq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, icq, querierCount[*ItemChangeQuery](), icq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (icq *ItemChangeQuery) CountX(ctx context.Context) int {
+	count, err := icq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (icq *ItemChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, icq.ctx, ent.OpQueryExist)
+	switch _, err := icq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (icq *ItemChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := icq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (icq *ItemChangeQuery) Clone() *ItemChangeQuery {
+	if icq == nil {
+		return nil
+	}
+	return &ItemChangeQuery{
+		config:     icq.config,
+		ctx:        icq.ctx.Clone(),
+		order:      append([]itemchange.OrderOption{}, icq.order...),
+		inters:     append([]Interceptor{}, icq.inters...),
+		predicates: append([]predicate.ItemChange{}, icq.predicates...),
+		// clone intermediate query.
+		sql:  icq.sql.Clone(),
+		path: icq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EventID string `json:"event_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemChange.Query().
+//		GroupBy(itemchange.FieldEventID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (icq *ItemChangeQuery) GroupBy(field string, fields ...string) *ItemChangeGroupBy {
+	icq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemChangeGroupBy{build: icq}
+	grbuild.flds = &icq.ctx.Fields
+	grbuild.label = itemchange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EventID string `json:"event_id,omitempty"`
+//	}
+//
+//	client.ItemChange.Query().
+//		Select(itemchange.FieldEventID).
+//		Scan(ctx, &v)
+func (icq *ItemChangeQuery) Select(fields ...string) *ItemChangeSelect {
+	icq.ctx.Fields = append(icq.ctx.Fields, fields...)
+	sbuild := &ItemChangeSelect{ItemChangeQuery: icq}
+	sbuild.label = itemchange.Label
+	sbuild.flds, sbuild.scan = &icq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemChangeSelect configured with the given aggregations.
+func (icq *ItemChangeQuery) Aggregate(fns ...AggregateFunc) *ItemChangeSelect {
+	return icq.Select().Aggregate(fns...)
+}
+
+func (icq *ItemChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range icq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, icq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range icq.ctx.Fields {
+		if !itemchange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if icq.path != nil {
+		prev, err := icq.path(ctx)
+		if err != nil {
+			return err
+		}
+		icq.sql = prev
+	}
+	return nil
+}
+
+func (icq *ItemChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemChange, error) {
+	var (
+		nodes = []*ItemChange{}
+		_spec = icq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemChange{config: icq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(icq.modifiers) > 0 {
+		_spec.Modifiers = icq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, icq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (icq *ItemChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := icq.querySpec()
+	if len(icq.modifiers) > 0 {
+		_spec.Modifiers = icq.modifiers
+	}
+	_spec.Node.Columns = icq.ctx.Fields
+	if len(icq.ctx.Fields) > 0 {
+		_spec.Unique = icq.ctx.Unique != nil && *icq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, icq.driver, _spec)
+}
+
+func (icq *ItemChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemchange.Table, itemchange.Columns, sqlgraph.NewFieldSpec(itemchange.FieldID, field.TypeInt64))
+	_spec.From = icq.sql
+	if unique := icq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if icq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := icq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemchange.FieldID)
+		for i := range fields {
+			if fields[i] != itemchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := icq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := icq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := icq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := icq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (icq *ItemChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(icq.driver.Dialect())
+	t1 := builder.Table(itemchange.Table)
+	columns := icq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemchange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if icq.sql != nil {
+		selector = icq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if icq.ctx.Unique != nil && *icq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range icq.modifiers {
+		m(selector)
+	}
+	for _, p := range icq.predicates {
+		p(selector)
+	}
+	for _, p := range icq.order {
+		p(selector)
+	}
+	if offset := icq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := icq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (icq *ItemChangeQuery) ForUpdate(opts ...sql.LockOption) *ItemChangeQuery {
+	if icq.driver.Dialect() == dialect.Postgres {
+		icq.Unique(false)
+	}
+	icq.modifiers = append(icq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return icq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (icq *ItemChangeQuery) ForShare(opts ...sql.LockOption) *ItemChangeQuery {
+	if icq.driver.Dialect() == dialect.Postgres {
+		icq.Unique(false)
+	}
+	icq.modifiers = append(icq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return icq
+}
+
+// ItemChangeGroupBy is the group-by builder for ItemChange entities.
+type ItemChangeGroupBy struct {
+	selector
+	build *ItemChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (icgb *ItemChangeGroupBy) Aggregate(fns ...AggregateFunc) *ItemChangeGroupBy {
+	icgb.fns = append(icgb.fns, fns...)
+	return icgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (icgb *ItemChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, icgb.build.ctx, ent.OpQueryGroupBy)
+	if err := icgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemChangeQuery, *ItemChangeGroupBy](ctx, icgb.build, icgb, icgb.build.inters, v)
+}
+
+func (icgb *ItemChangeGroupBy) sqlScan(ctx context.Context, root *ItemChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(icgb.fns))
+	for _, fn := range icgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*icgb.flds)+len(icgb.fns))
+		for _, f := range *icgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*icgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := icgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemChangeSelect is the builder for selecting fields of ItemChange entities.
+type ItemChangeSelect struct {
+	*ItemChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ics *ItemChangeSelect) Aggregate(fns ...AggregateFunc) *ItemChangeSelect {
+	ics.fns = append(ics.fns, fns...)
+	return ics
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ics *ItemChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ics.ctx, ent.OpQuerySelect)
+	if err := ics.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemChangeQuery, *ItemChangeSelect](ctx, ics.ItemChangeQuery, ics, ics.inters, v)
+}
+
+func (ics *ItemChangeSelect) sqlScan(ctx context.Context, root *ItemChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ics.fns))
+	for _, fn := range ics.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ics.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ics.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemchange"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+)
+
+// ItemChangeUpdate is the builder for updating ItemChange entities.
+type ItemChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemChangeMutation
+}
+
+// Where appends a list predicates to the ItemChangeUpdate builder.
+func (icu *ItemChangeUpdate) Where(ps ...predicate.ItemChange) *ItemChangeUpdate {
+	icu.mutation.Where(ps...)
+	return icu
+}
+
+// Mutation returns the ItemChangeMutation object of the builder.
+func (icu *ItemChangeUpdate) Mutation() *ItemChangeMutation {
+	return icu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (icu *ItemChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, icu.sqlSave, icu.mutation, icu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icu *ItemChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := icu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (icu *ItemChangeUpdate) Exec(ctx context.Context) error {
+	_, err := icu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icu *ItemChangeUpdate) ExecX(ctx context.Context) {
+	if err := icu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (icu *ItemChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(itemchange.Table, itemchange.Columns, sqlgraph.NewFieldSpec(itemchange.FieldID, field.TypeInt64))
+	if ps := icu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if icu.mutation.TagsCleared() {
+		_spec.ClearField(itemchange.FieldTags, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, icu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	icu.mutation.done = true
+	return n, nil
+}
+
+// ItemChangeUpdateOne is the builder for updating a single ItemChange entity.
+type ItemChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemChangeMutation
+}
+
+// Mutation returns the ItemChangeMutation object of the builder.
+func (icuo *ItemChangeUpdateOne) Mutation() *ItemChangeMutation {
+	return icuo.mutation
+}
+
+// Where appends a list predicates to the ItemChangeUpdate builder.
+func (icuo *ItemChangeUpdateOne) Where(ps ...predicate.ItemChange) *ItemChangeUpdateOne {
+	icuo.mutation.Where(ps...)
+	return icuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (icuo *ItemChangeUpdateOne) Select(field string, fields ...string) *ItemChangeUpdateOne {
+	icuo.fields = append([]string{field}, fields...)
+	return icuo
+}
+
+// Save executes the query and returns the updated ItemChange entity.
+func (icuo *ItemChangeUpdateOne) Save(ctx context.Context) (*ItemChange, error) {
+	return withHooks(ctx, icuo.sqlSave, icuo.mutation, icuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icuo *ItemChangeUpdateOne) SaveX(ctx context.Context) *ItemChange {
+	node, err := icuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (icuo *ItemChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := icuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icuo *ItemChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := icuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (icuo *ItemChangeUpdateOne) sqlSave(ctx context.Context) (_node *ItemChange, err error) {
+	_spec := sqlgraph.NewUpdateSpec(itemchange.Table, itemchange.Columns, sqlgraph.NewFieldSpec(itemchange.FieldID, field.TypeInt64))
+	id, ok := icuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := icuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemchange.FieldID)
+		for _, f := range fields {
+			if !itemchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := icuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if icuo.mutation.TagsCleared() {
+		_spec.ClearField(itemchange.FieldTags, field.TypeJSON)
+	}
+	_node = &ItemChange{config: icuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, icuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	icuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemrevision"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/audit"
+)
+
+// ItemRevision is the model entity for the ItemRevision schema.
+type ItemRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID string `json:"item_id,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation itemrevision.Operation `json:"operation,omitempty"`
+	// ItemVersion holds the value of the "item_version" field.
+	ItemVersion int64 `json:"item_version,omitempty"`
+	// Snapshot holds the value of the "snapshot" field.
+	Snapshot audit.Snapshot `json:"snapshot,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes map[string]audit.Change `json:"changes,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID string `json:"request_id,omitempty"`
+	// RevertedFrom holds the value of the "reverted_from" field.
+	RevertedFrom string `json:"reverted_from,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemrevision.FieldSnapshot, itemrevision.FieldChanges:
+			values[i] = new([]byte)
+		case itemrevision.FieldItemVersion:
+			values[i] = new(sql.NullInt64)
+		case itemrevision.FieldID, itemrevision.FieldItemID, itemrevision.FieldOperation, itemrevision.FieldActor, itemrevision.FieldRequestID, itemrevision.FieldRevertedFrom:
+			values[i] = new(sql.NullString)
+		case itemrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemRevision fields.
+func (ir *ItemRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemrevision.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ir.ID = value.String
+			}
+		case itemrevision.FieldItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				ir.ItemID = value.String
+			}
+		case itemrevision.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				ir.Operation = itemrevision.Operation(value.String)
+			}
+		case itemrevision.FieldItemVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_version", values[i])
+			} else if value.Valid {
+				ir.ItemVersion = value.Int64
+			}
+		case itemrevision.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ir.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case itemrevision.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ir.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case itemrevision.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				ir.Actor = value.String
+			}
+		case itemrevision.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				ir.RequestID = value.String
+			}
+		case itemrevision.FieldRevertedFrom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reverted_from", values[i])
+			} else if value.Valid {
+				ir.RevertedFrom = value.String
+			}
+		case itemrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ir.CreatedAt = value.Time
+			}
+		default:
+			ir.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemRevision.
+// This includes values selected through modifiers, order, etc.
+func (ir *ItemRevision) Value(name string) (ent.Value, error) {
+	return ir.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ItemRevision.
+// Note that you need to call ItemRevision.Unwrap() before calling this method if this ItemRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ir *ItemRevision) Update() *ItemRevisionUpdateOne {
+	return NewItemRevisionClient(ir.config).UpdateOne(ir)
+}
+
+// Unwrap unwraps the ItemRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ir *ItemRevision) Unwrap() *ItemRevision {
+	_tx, ok := ir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemRevision is not a transactional entity")
+	}
+	ir.config.driver = _tx.drv
+	return ir
+}
+
+// String implements the fmt.Stringer.
+func (ir *ItemRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ir.ID))
+	builder.WriteString("item_id=")
+	builder.WriteString(ir.ItemID)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("item_version=")
+	builder.WriteString(fmt.Sprintf("%v", ir.ItemVersion))
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Snapshot))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(ir.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(ir.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("reverted_from=")
+	builder.WriteString(ir.RevertedFrom)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ir.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemRevisions is a parsable slice of ItemRevision.
+type ItemRevisions []*ItemRevision
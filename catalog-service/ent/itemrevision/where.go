@@ -0,0 +1,510 @@
+// Code generated by ent, DO NOT EDIT.
+
+package itemrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContainsFold(FieldID, id))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemVersion applies equality check predicate on the "item_version" field. It's identical to ItemVersionEQ.
+func ItemVersion(v int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldItemVersion, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldActor, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RevertedFrom applies equality check predicate on the "reverted_from" field. It's identical to RevertedFromEQ.
+func RevertedFrom(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldRevertedFrom, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDContains applies the Contains predicate on the "item_id" field.
+func ItemIDContains(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContains(FieldItemID, v))
+}
+
+// ItemIDHasPrefix applies the HasPrefix predicate on the "item_id" field.
+func ItemIDHasPrefix(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldHasPrefix(FieldItemID, v))
+}
+
+// ItemIDHasSuffix applies the HasSuffix predicate on the "item_id" field.
+func ItemIDHasSuffix(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldHasSuffix(FieldItemID, v))
+}
+
+// ItemIDEqualFold applies the EqualFold predicate on the "item_id" field.
+func ItemIDEqualFold(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEqualFold(FieldItemID, v))
+}
+
+// ItemIDContainsFold applies the ContainsFold predicate on the "item_id" field.
+func ItemIDContainsFold(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContainsFold(FieldItemID, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v Operation) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v Operation) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...Operation) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...Operation) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// ItemVersionEQ applies the EQ predicate on the "item_version" field.
+func ItemVersionEQ(v int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldItemVersion, v))
+}
+
+// ItemVersionNEQ applies the NEQ predicate on the "item_version" field.
+func ItemVersionNEQ(v int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNEQ(FieldItemVersion, v))
+}
+
+// ItemVersionIn applies the In predicate on the "item_version" field.
+func ItemVersionIn(vs ...int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIn(FieldItemVersion, vs...))
+}
+
+// ItemVersionNotIn applies the NotIn predicate on the "item_version" field.
+func ItemVersionNotIn(vs ...int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotIn(FieldItemVersion, vs...))
+}
+
+// ItemVersionGT applies the GT predicate on the "item_version" field.
+func ItemVersionGT(v int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGT(FieldItemVersion, v))
+}
+
+// ItemVersionGTE applies the GTE predicate on the "item_version" field.
+func ItemVersionGTE(v int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGTE(FieldItemVersion, v))
+}
+
+// ItemVersionLT applies the LT predicate on the "item_version" field.
+func ItemVersionLT(v int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLT(FieldItemVersion, v))
+}
+
+// ItemVersionLTE applies the LTE predicate on the "item_version" field.
+func ItemVersionLTE(v int64) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLTE(FieldItemVersion, v))
+}
+
+// ChangesIsNil applies the IsNil predicate on the "changes" field.
+func ChangesIsNil() predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIsNull(FieldChanges))
+}
+
+// ChangesNotNil applies the NotNil predicate on the "changes" field.
+func ChangesNotNil() predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotNull(FieldChanges))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIsNull(FieldActor))
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotNull(FieldActor))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContainsFold(FieldActor, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// RevertedFromEQ applies the EQ predicate on the "reverted_from" field.
+func RevertedFromEQ(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldRevertedFrom, v))
+}
+
+// RevertedFromNEQ applies the NEQ predicate on the "reverted_from" field.
+func RevertedFromNEQ(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNEQ(FieldRevertedFrom, v))
+}
+
+// RevertedFromIn applies the In predicate on the "reverted_from" field.
+func RevertedFromIn(vs ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIn(FieldRevertedFrom, vs...))
+}
+
+// RevertedFromNotIn applies the NotIn predicate on the "reverted_from" field.
+func RevertedFromNotIn(vs ...string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotIn(FieldRevertedFrom, vs...))
+}
+
+// RevertedFromGT applies the GT predicate on the "reverted_from" field.
+func RevertedFromGT(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGT(FieldRevertedFrom, v))
+}
+
+// RevertedFromGTE applies the GTE predicate on the "reverted_from" field.
+func RevertedFromGTE(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGTE(FieldRevertedFrom, v))
+}
+
+// RevertedFromLT applies the LT predicate on the "reverted_from" field.
+func RevertedFromLT(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLT(FieldRevertedFrom, v))
+}
+
+// RevertedFromLTE applies the LTE predicate on the "reverted_from" field.
+func RevertedFromLTE(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLTE(FieldRevertedFrom, v))
+}
+
+// RevertedFromContains applies the Contains predicate on the "reverted_from" field.
+func RevertedFromContains(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContains(FieldRevertedFrom, v))
+}
+
+// RevertedFromHasPrefix applies the HasPrefix predicate on the "reverted_from" field.
+func RevertedFromHasPrefix(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldHasPrefix(FieldRevertedFrom, v))
+}
+
+// RevertedFromHasSuffix applies the HasSuffix predicate on the "reverted_from" field.
+func RevertedFromHasSuffix(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldHasSuffix(FieldRevertedFrom, v))
+}
+
+// RevertedFromIsNil applies the IsNil predicate on the "reverted_from" field.
+func RevertedFromIsNil() predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIsNull(FieldRevertedFrom))
+}
+
+// RevertedFromNotNil applies the NotNil predicate on the "reverted_from" field.
+func RevertedFromNotNil() predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotNull(FieldRevertedFrom))
+}
+
+// RevertedFromEqualFold applies the EqualFold predicate on the "reverted_from" field.
+func RevertedFromEqualFold(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEqualFold(FieldRevertedFrom, v))
+}
+
+// RevertedFromContainsFold applies the ContainsFold predicate on the "reverted_from" field.
+func RevertedFromContainsFold(v string) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldContainsFold(FieldRevertedFrom, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ItemRevision) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ItemRevision) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ItemRevision) predicate.ItemRevision {
+	return predicate.ItemRevision(sql.NotPredicates(p))
+}
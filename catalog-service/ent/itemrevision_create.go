@@ -0,0 +1,656 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemrevision"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/audit"
+)
+
+// ItemRevisionCreate is the builder for creating a ItemRevision entity.
+type ItemRevisionCreate struct {
+	config
+	mutation *ItemRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetItemID sets the "item_id" field.
+func (irc *ItemRevisionCreate) SetItemID(s string) *ItemRevisionCreate {
+	irc.mutation.SetItemID(s)
+	return irc
+}
+
+// SetOperation sets the "operation" field.
+func (irc *ItemRevisionCreate) SetOperation(i itemrevision.Operation) *ItemRevisionCreate {
+	irc.mutation.SetOperation(i)
+	return irc
+}
+
+// SetItemVersion sets the "item_version" field.
+func (irc *ItemRevisionCreate) SetItemVersion(i int64) *ItemRevisionCreate {
+	irc.mutation.SetItemVersion(i)
+	return irc
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (irc *ItemRevisionCreate) SetSnapshot(a audit.Snapshot) *ItemRevisionCreate {
+	irc.mutation.SetSnapshot(a)
+	return irc
+}
+
+// SetChanges sets the "changes" field.
+func (irc *ItemRevisionCreate) SetChanges(m map[string]audit.Change) *ItemRevisionCreate {
+	irc.mutation.SetChanges(m)
+	return irc
+}
+
+// SetActor sets the "actor" field.
+func (irc *ItemRevisionCreate) SetActor(s string) *ItemRevisionCreate {
+	irc.mutation.SetActor(s)
+	return irc
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableActor(s *string) *ItemRevisionCreate {
+	if s != nil {
+		irc.SetActor(*s)
+	}
+	return irc
+}
+
+// SetRequestID sets the "request_id" field.
+func (irc *ItemRevisionCreate) SetRequestID(s string) *ItemRevisionCreate {
+	irc.mutation.SetRequestID(s)
+	return irc
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableRequestID(s *string) *ItemRevisionCreate {
+	if s != nil {
+		irc.SetRequestID(*s)
+	}
+	return irc
+}
+
+// SetRevertedFrom sets the "reverted_from" field.
+func (irc *ItemRevisionCreate) SetRevertedFrom(s string) *ItemRevisionCreate {
+	irc.mutation.SetRevertedFrom(s)
+	return irc
+}
+
+// SetNillableRevertedFrom sets the "reverted_from" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableRevertedFrom(s *string) *ItemRevisionCreate {
+	if s != nil {
+		irc.SetRevertedFrom(*s)
+	}
+	return irc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (irc *ItemRevisionCreate) SetCreatedAt(t time.Time) *ItemRevisionCreate {
+	irc.mutation.SetCreatedAt(t)
+	return irc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableCreatedAt(t *time.Time) *ItemRevisionCreate {
+	if t != nil {
+		irc.SetCreatedAt(*t)
+	}
+	return irc
+}
+
+// SetID sets the "id" field.
+func (irc *ItemRevisionCreate) SetID(s string) *ItemRevisionCreate {
+	irc.mutation.SetID(s)
+	return irc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (irc *ItemRevisionCreate) SetNillableID(s *string) *ItemRevisionCreate {
+	if s != nil {
+		irc.SetID(*s)
+	}
+	return irc
+}
+
+// Mutation returns the ItemRevisionMutation object of the builder.
+func (irc *ItemRevisionCreate) Mutation() *ItemRevisionMutation {
+	return irc.mutation
+}
+
+// Save creates the ItemRevision in the database.
+func (irc *ItemRevisionCreate) Save(ctx context.Context) (*ItemRevision, error) {
+	irc.defaults()
+	return withHooks(ctx, irc.sqlSave, irc.mutation, irc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irc *ItemRevisionCreate) SaveX(ctx context.Context) *ItemRevision {
+	v, err := irc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irc *ItemRevisionCreate) Exec(ctx context.Context) error {
+	_, err := irc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irc *ItemRevisionCreate) ExecX(ctx context.Context) {
+	if err := irc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (irc *ItemRevisionCreate) defaults() {
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		v := itemrevision.DefaultCreatedAt()
+		irc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := irc.mutation.ID(); !ok {
+		v := itemrevision.DefaultID()
+		irc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irc *ItemRevisionCreate) check() error {
+	if _, ok := irc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ItemRevision.item_id"`)}
+	}
+	if _, ok := irc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "ItemRevision.operation"`)}
+	}
+	if v, ok := irc.mutation.Operation(); ok {
+		if err := itemrevision.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "ItemRevision.operation": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.ItemVersion(); !ok {
+		return &ValidationError{Name: "item_version", err: errors.New(`ent: missing required field "ItemRevision.item_version"`)}
+	}
+	if _, ok := irc.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "ItemRevision.snapshot"`)}
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemRevision.created_at"`)}
+	}
+	return nil
+}
+
+func (irc *ItemRevisionCreate) sqlSave(ctx context.Context) (*ItemRevision, error) {
+	if err := irc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := irc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ItemRevision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	irc.mutation.id = &_node.ID
+	irc.mutation.done = true
+	return _node, nil
+}
+
+func (irc *ItemRevisionCreate) createSpec() (*ItemRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemRevision{config: irc.config}
+		_spec = sqlgraph.NewCreateSpec(itemrevision.Table, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = irc.conflict
+	if id, ok := irc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := irc.mutation.ItemID(); ok {
+		_spec.SetField(itemrevision.FieldItemID, field.TypeString, value)
+		_node.ItemID = value
+	}
+	if value, ok := irc.mutation.Operation(); ok {
+		_spec.SetField(itemrevision.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := irc.mutation.ItemVersion(); ok {
+		_spec.SetField(itemrevision.FieldItemVersion, field.TypeInt64, value)
+		_node.ItemVersion = value
+	}
+	if value, ok := irc.mutation.Snapshot(); ok {
+		_spec.SetField(itemrevision.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := irc.mutation.Changes(); ok {
+		_spec.SetField(itemrevision.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := irc.mutation.Actor(); ok {
+		_spec.SetField(itemrevision.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := irc.mutation.RequestID(); ok {
+		_spec.SetField(itemrevision.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := irc.mutation.RevertedFrom(); ok {
+		_spec.SetField(itemrevision.FieldRevertedFrom, field.TypeString, value)
+		_node.RevertedFrom = value
+	}
+	if value, ok := irc.mutation.CreatedAt(); ok {
+		_spec.SetField(itemrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemRevision.Create().
+//		SetItemID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemRevisionUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (irc *ItemRevisionCreate) OnConflict(opts ...sql.ConflictOption) *ItemRevisionUpsertOne {
+	irc.conflict = opts
+	return &ItemRevisionUpsertOne{
+		create: irc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (irc *ItemRevisionCreate) OnConflictColumns(columns ...string) *ItemRevisionUpsertOne {
+	irc.conflict = append(irc.conflict, sql.ConflictColumns(columns...))
+	return &ItemRevisionUpsertOne{
+		create: irc,
+	}
+}
+
+type (
+	// ItemRevisionUpsertOne is the builder for "upsert"-ing
+	//  one ItemRevision node.
+	ItemRevisionUpsertOne struct {
+		create *ItemRevisionCreate
+	}
+
+	// ItemRevisionUpsert is the "OnConflict" setter.
+	ItemRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ItemRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(itemrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemRevisionUpsertOne) UpdateNewValues() *ItemRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(itemrevision.FieldID)
+		}
+		if _, exists := u.create.mutation.ItemID(); exists {
+			s.SetIgnore(itemrevision.FieldItemID)
+		}
+		if _, exists := u.create.mutation.Operation(); exists {
+			s.SetIgnore(itemrevision.FieldOperation)
+		}
+		if _, exists := u.create.mutation.ItemVersion(); exists {
+			s.SetIgnore(itemrevision.FieldItemVersion)
+		}
+		if _, exists := u.create.mutation.Snapshot(); exists {
+			s.SetIgnore(itemrevision.FieldSnapshot)
+		}
+		if _, exists := u.create.mutation.Changes(); exists {
+			s.SetIgnore(itemrevision.FieldChanges)
+		}
+		if _, exists := u.create.mutation.Actor(); exists {
+			s.SetIgnore(itemrevision.FieldActor)
+		}
+		if _, exists := u.create.mutation.RequestID(); exists {
+			s.SetIgnore(itemrevision.FieldRequestID)
+		}
+		if _, exists := u.create.mutation.RevertedFrom(); exists {
+			s.SetIgnore(itemrevision.FieldRevertedFrom)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(itemrevision.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ItemRevisionUpsertOne) Ignore() *ItemRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemRevisionUpsertOne) DoNothing() *ItemRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemRevisionCreate.OnConflict
+// documentation for more info.
+func (u *ItemRevisionUpsertOne) Update(set func(*ItemRevisionUpsert)) *ItemRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ItemRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItemRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ItemRevisionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ItemRevisionUpsertOne.ID is not supported by MySQL driver. Use ItemRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ItemRevisionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ItemRevisionCreateBulk is the builder for creating many ItemRevision entities in bulk.
+type ItemRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*ItemRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ItemRevision entities in the database.
+func (ircb *ItemRevisionCreateBulk) Save(ctx context.Context) ([]*ItemRevision, error) {
+	if ircb.err != nil {
+		return nil, ircb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ircb.builders))
+	nodes := make([]*ItemRevision, len(ircb.builders))
+	mutators := make([]Mutator, len(ircb.builders))
+	for i := range ircb.builders {
+		func(i int, root context.Context) {
+			builder := ircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ircb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ircb *ItemRevisionCreateBulk) SaveX(ctx context.Context) []*ItemRevision {
+	v, err := ircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ircb *ItemRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ircb *ItemRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := ircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ItemRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemRevisionUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (ircb *ItemRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ItemRevisionUpsertBulk {
+	ircb.conflict = opts
+	return &ItemRevisionUpsertBulk{
+		create: ircb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ItemRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ircb *ItemRevisionCreateBulk) OnConflictColumns(columns ...string) *ItemRevisionUpsertBulk {
+	ircb.conflict = append(ircb.conflict, sql.ConflictColumns(columns...))
+	return &ItemRevisionUpsertBulk{
+		create: ircb,
+	}
+}
+
+// ItemRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ItemRevision nodes.
+type ItemRevisionUpsertBulk struct {
+	create *ItemRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ItemRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(itemrevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ItemRevisionUpsertBulk) UpdateNewValues() *ItemRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(itemrevision.FieldID)
+			}
+			if _, exists := b.mutation.ItemID(); exists {
+				s.SetIgnore(itemrevision.FieldItemID)
+			}
+			if _, exists := b.mutation.Operation(); exists {
+				s.SetIgnore(itemrevision.FieldOperation)
+			}
+			if _, exists := b.mutation.ItemVersion(); exists {
+				s.SetIgnore(itemrevision.FieldItemVersion)
+			}
+			if _, exists := b.mutation.Snapshot(); exists {
+				s.SetIgnore(itemrevision.FieldSnapshot)
+			}
+			if _, exists := b.mutation.Changes(); exists {
+				s.SetIgnore(itemrevision.FieldChanges)
+			}
+			if _, exists := b.mutation.Actor(); exists {
+				s.SetIgnore(itemrevision.FieldActor)
+			}
+			if _, exists := b.mutation.RequestID(); exists {
+				s.SetIgnore(itemrevision.FieldRequestID)
+			}
+			if _, exists := b.mutation.RevertedFrom(); exists {
+				s.SetIgnore(itemrevision.FieldRevertedFrom)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(itemrevision.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ItemRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ItemRevisionUpsertBulk) Ignore() *ItemRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemRevisionUpsertBulk) DoNothing() *ItemRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ItemRevisionUpsertBulk) Update(set func(*ItemRevisionUpsert)) *ItemRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ItemRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ItemRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItemRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
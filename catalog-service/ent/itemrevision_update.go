@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemrevision"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+)
+
+// ItemRevisionUpdate is the builder for updating ItemRevision entities.
+type ItemRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemRevisionMutation
+}
+
+// Where appends a list predicates to the ItemRevisionUpdate builder.
+func (iru *ItemRevisionUpdate) Where(ps ...predicate.ItemRevision) *ItemRevisionUpdate {
+	iru.mutation.Where(ps...)
+	return iru
+}
+
+// Mutation returns the ItemRevisionMutation object of the builder.
+func (iru *ItemRevisionUpdate) Mutation() *ItemRevisionMutation {
+	return iru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iru *ItemRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iru.sqlSave, iru.mutation, iru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iru *ItemRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := iru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iru *ItemRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := iru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iru *ItemRevisionUpdate) ExecX(ctx context.Context) {
+	if err := iru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iru *ItemRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(itemrevision.Table, itemrevision.Columns, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeString))
+	if ps := iru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if iru.mutation.ChangesCleared() {
+		_spec.ClearField(itemrevision.FieldChanges, field.TypeJSON)
+	}
+	if iru.mutation.ActorCleared() {
+		_spec.ClearField(itemrevision.FieldActor, field.TypeString)
+	}
+	if iru.mutation.RequestIDCleared() {
+		_spec.ClearField(itemrevision.FieldRequestID, field.TypeString)
+	}
+	if iru.mutation.RevertedFromCleared() {
+		_spec.ClearField(itemrevision.FieldRevertedFrom, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iru.mutation.done = true
+	return n, nil
+}
+
+// ItemRevisionUpdateOne is the builder for updating a single ItemRevision entity.
+type ItemRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemRevisionMutation
+}
+
+// Mutation returns the ItemRevisionMutation object of the builder.
+func (iruo *ItemRevisionUpdateOne) Mutation() *ItemRevisionMutation {
+	return iruo.mutation
+}
+
+// Where appends a list predicates to the ItemRevisionUpdate builder.
+func (iruo *ItemRevisionUpdateOne) Where(ps ...predicate.ItemRevision) *ItemRevisionUpdateOne {
+	iruo.mutation.Where(ps...)
+	return iruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iruo *ItemRevisionUpdateOne) Select(field string, fields ...string) *ItemRevisionUpdateOne {
+	iruo.fields = append([]string{field}, fields...)
+	return iruo
+}
+
+// Save executes the query and returns the updated ItemRevision entity.
+func (iruo *ItemRevisionUpdateOne) Save(ctx context.Context) (*ItemRevision, error) {
+	return withHooks(ctx, iruo.sqlSave, iruo.mutation, iruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iruo *ItemRevisionUpdateOne) SaveX(ctx context.Context) *ItemRevision {
+	node, err := iruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iruo *ItemRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := iruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iruo *ItemRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := iruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (iruo *ItemRevisionUpdateOne) sqlSave(ctx context.Context) (_node *ItemRevision, err error) {
+	_spec := sqlgraph.NewUpdateSpec(itemrevision.Table, itemrevision.Columns, sqlgraph.NewFieldSpec(itemrevision.FieldID, field.TypeString))
+	id, ok := iruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemrevision.FieldID)
+		for _, f := range fields {
+			if !itemrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if iruo.mutation.ChangesCleared() {
+		_spec.ClearField(itemrevision.FieldChanges, field.TypeJSON)
+	}
+	if iruo.mutation.ActorCleared() {
+		_spec.ClearField(itemrevision.FieldActor, field.TypeString)
+	}
+	if iruo.mutation.RequestIDCleared() {
+		_spec.ClearField(itemrevision.FieldRequestID, field.TypeString)
+	}
+	if iruo.mutation.RevertedFromCleared() {
+		_spec.ClearField(itemrevision.FieldRevertedFrom, field.TypeString)
+	}
+	_node = &ItemRevision{config: iruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iruo.mutation.done = true
+	return _node, nil
+}
@@ -3,20 +3,82 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[9]},
+			},
+		},
+	}
+	// CategoriesColumns holds the columns for the "categories" table.
+	CategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
+	}
+	// CategoriesTable holds the schema information for the "categories" table.
+	CategoriesTable = &schema.Table{
+		Name:       "categories",
+		Columns:    CategoriesColumns,
+		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_categories_children",
+				Columns:    []*schema.Column{CategoriesColumns[7]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "category_parent_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{CategoriesColumns[7], CategoriesColumns[4]},
+			},
+		},
+	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "external_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "image_url", Type: field.TypeString, Nullable: true},
 		{Name: "rating", Type: field.TypeFloat64, Default: 0},
 		{Name: "review_count", Type: field.TypeInt, Default: 0},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -27,27 +89,225 @@ var (
 		PrimaryKey: []*schema.Column{ItemsColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "item_title",
+				Name:    "item_deleted_at",
 				Unique:  false,
 				Columns: []*schema.Column{ItemsColumns[1]},
 			},
 			{
-				Name:    "item_tags",
+				Name:    "item_title",
 				Unique:  false,
 				Columns: []*schema.Column{ItemsColumns[3]},
 			},
 			{
-				Name:    "item_rating",
+				Name:    "item_tags",
 				Unique:  false,
 				Columns: []*schema.Column{ItemsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "item_rating",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[7]},
+			},
+		},
+	}
+	// ItemChangesColumns holds the columns for the "item_changes" table.
+	ItemChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ItemChangesTable holds the schema information for the "item_changes" table.
+	ItemChangesTable = &schema.Table{
+		Name:       "item_changes",
+		Columns:    ItemChangesColumns,
+		PrimaryKey: []*schema.Column{ItemChangesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemchange_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemChangesColumns[6]},
+			},
+		},
+	}
+	// ItemRevisionsColumns holds the columns for the "item_revisions" table.
+	ItemRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore", "purge"}},
+		{Name: "item_version", Type: field.TypeInt64},
+		{Name: "snapshot", Type: field.TypeJSON},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "reverted_from", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ItemRevisionsTable holds the schema information for the "item_revisions" table.
+	ItemRevisionsTable = &schema.Table{
+		Name:       "item_revisions",
+		Columns:    ItemRevisionsColumns,
+		PrimaryKey: []*schema.Column{ItemRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemrevision_item_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ItemRevisionsColumns[1], ItemRevisionsColumns[9]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "aggregate_type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_published_at_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[6], OutboxEventsColumns[5]},
+			},
+		},
+	}
+	// ReviewsColumns holds the columns for the "reviews" table.
+	ReviewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "score", Type: field.TypeInt},
+		{Name: "text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "item_id", Type: field.TypeString},
+	}
+	// ReviewsTable holds the schema information for the "reviews" table.
+	ReviewsTable = &schema.Table{
+		Name:       "reviews",
+		Columns:    ReviewsColumns,
+		PrimaryKey: []*schema.Column{ReviewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reviews_items_reviews",
+				Columns:    []*schema.Column{ReviewsColumns[5]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "review_item_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewsColumns[5], ReviewsColumns[4]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "usage_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_usage_count",
+				Unique:  false,
+				Columns: []*schema.Column{TagsColumns[4]},
+			},
+		},
+	}
+	// TagSynonymsColumns holds the columns for the "tag_synonyms" table.
+	TagSynonymsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tag_id", Type: field.TypeString},
+	}
+	// TagSynonymsTable holds the schema information for the "tag_synonyms" table.
+	TagSynonymsTable = &schema.Table{
+		Name:       "tag_synonyms",
+		Columns:    TagSynonymsColumns,
+		PrimaryKey: []*schema.Column{TagSynonymsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_synonyms_tags_synonyms",
+				Columns:    []*schema.Column{TagSynonymsColumns[4]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// CategoryItemsColumns holds the columns for the "category_items" table.
+	CategoryItemsColumns = []*schema.Column{
+		{Name: "category_id", Type: field.TypeString},
+		{Name: "item_id", Type: field.TypeString},
+	}
+	// CategoryItemsTable holds the schema information for the "category_items" table.
+	CategoryItemsTable = &schema.Table{
+		Name:       "category_items",
+		Columns:    CategoryItemsColumns,
+		PrimaryKey: []*schema.Column{CategoryItemsColumns[0], CategoryItemsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_items_category_id",
+				Columns:    []*schema.Column{CategoryItemsColumns[0]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "category_items_item_id",
+				Columns:    []*schema.Column{CategoryItemsColumns[1]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		CategoriesTable,
 		ItemsTable,
+		ItemChangesTable,
+		ItemRevisionsTable,
+		OutboxEventsTable,
+		ReviewsTable,
+		TagsTable,
+		TagSynonymsTable,
+		CategoryItemsTable,
 	}
 )
 
 func init() {
+	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
+	ReviewsTable.ForeignKeys[0].RefTable = ItemsTable
+	TagSynonymsTable.ForeignKeys[0].RefTable = TagsTable
+	CategoryItemsTable.ForeignKeys[0].RefTable = CategoriesTable
+	CategoryItemsTable.ForeignKeys[1].RefTable = ItemsTable
 }
@@ -6,5 +6,29 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// Category is the predicate function for category builders.
+type Category func(*sql.Selector)
+
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
+
+// ItemChange is the predicate function for itemchange builders.
+type ItemChange func(*sql.Selector)
+
+// ItemRevision is the predicate function for itemrevision builders.
+type ItemRevision func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// Review is the predicate function for review builders.
+type Review func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// TagSynonym is the predicate function for tagsynonym builders.
+type TagSynonym func(*sql.Selector)
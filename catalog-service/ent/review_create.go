@@ -0,0 +1,775 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/review"
+)
+
+// ReviewCreate is the builder for creating a Review entity.
+type ReviewCreate struct {
+	config
+	mutation *ReviewMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetItemID sets the "item_id" field.
+func (rc *ReviewCreate) SetItemID(s string) *ReviewCreate {
+	rc.mutation.SetItemID(s)
+	return rc
+}
+
+// SetAuthor sets the "author" field.
+func (rc *ReviewCreate) SetAuthor(s string) *ReviewCreate {
+	rc.mutation.SetAuthor(s)
+	return rc
+}
+
+// SetScore sets the "score" field.
+func (rc *ReviewCreate) SetScore(i int) *ReviewCreate {
+	rc.mutation.SetScore(i)
+	return rc
+}
+
+// SetText sets the "text" field.
+func (rc *ReviewCreate) SetText(s string) *ReviewCreate {
+	rc.mutation.SetText(s)
+	return rc
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableText(s *string) *ReviewCreate {
+	if s != nil {
+		rc.SetText(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReviewCreate) SetCreatedAt(t time.Time) *ReviewCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableCreatedAt(t *time.Time) *ReviewCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReviewCreate) SetID(s string) *ReviewCreate {
+	rc.mutation.SetID(s)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ReviewCreate) SetNillableID(s *string) *ReviewCreate {
+	if s != nil {
+		rc.SetID(*s)
+	}
+	return rc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (rc *ReviewCreate) SetItem(i *Item) *ReviewCreate {
+	return rc.SetItemID(i.ID)
+}
+
+// Mutation returns the ReviewMutation object of the builder.
+func (rc *ReviewCreate) Mutation() *ReviewMutation {
+	return rc.mutation
+}
+
+// Save creates the Review in the database.
+func (rc *ReviewCreate) Save(ctx context.Context) (*Review, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReviewCreate) SaveX(ctx context.Context) *Review {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReviewCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReviewCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReviewCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := review.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := review.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReviewCreate) check() error {
+	if _, ok := rc.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "Review.item_id"`)}
+	}
+	if _, ok := rc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "Review.author"`)}
+	}
+	if v, ok := rc.mutation.Author(); ok {
+		if err := review.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "Review.author": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "Review.score"`)}
+	}
+	if v, ok := rc.mutation.Score(); ok {
+		if err := review.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "Review.score": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Review.created_at"`)}
+	}
+	if len(rc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "Review.item"`)}
+	}
+	return nil
+}
+
+func (rc *ReviewCreate) sqlSave(ctx context.Context) (*Review, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Review.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Review{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(review.Table, sqlgraph.NewFieldSpec(review.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = rc.conflict
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.Author(); ok {
+		_spec.SetField(review.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := rc.mutation.Score(); ok {
+		_spec.SetField(review.FieldScore, field.TypeInt, value)
+		_node.Score = value
+	}
+	if value, ok := rc.mutation.Text(); ok {
+		_spec.SetField(review.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(review.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := rc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.ItemTable,
+			Columns: []string{review.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Review.Create().
+//		SetItemID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ReviewCreate) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertOne {
+	rc.conflict = opts
+	return &ReviewUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ReviewCreate) OnConflictColumns(columns ...string) *ReviewUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ReviewUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ReviewUpsertOne is the builder for "upsert"-ing
+	//  one Review node.
+	ReviewUpsertOne struct {
+		create *ReviewCreate
+	}
+
+	// ReviewUpsert is the "OnConflict" setter.
+	ReviewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetItemID sets the "item_id" field.
+func (u *ReviewUpsert) SetItemID(v string) *ReviewUpsert {
+	u.Set(review.FieldItemID, v)
+	return u
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateItemID() *ReviewUpsert {
+	u.SetExcluded(review.FieldItemID)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *ReviewUpsert) SetAuthor(v string) *ReviewUpsert {
+	u.Set(review.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateAuthor() *ReviewUpsert {
+	u.SetExcluded(review.FieldAuthor)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *ReviewUpsert) SetScore(v int) *ReviewUpsert {
+	u.Set(review.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateScore() *ReviewUpsert {
+	u.SetExcluded(review.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *ReviewUpsert) AddScore(v int) *ReviewUpsert {
+	u.Add(review.FieldScore, v)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *ReviewUpsert) SetText(v string) *ReviewUpsert {
+	u.Set(review.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *ReviewUpsert) UpdateText() *ReviewUpsert {
+	u.SetExcluded(review.FieldText)
+	return u
+}
+
+// ClearText clears the value of the "text" field.
+func (u *ReviewUpsert) ClearText() *ReviewUpsert {
+	u.SetNull(review.FieldText)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(review.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewUpsertOne) UpdateNewValues() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(review.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(review.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReviewUpsertOne) Ignore() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewUpsertOne) DoNothing() *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewCreate.OnConflict
+// documentation for more info.
+func (u *ReviewUpsertOne) Update(set func(*ReviewUpsert)) *ReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReviewUpsertOne) SetItemID(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateItemID() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *ReviewUpsertOne) SetAuthor(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateAuthor() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ReviewUpsertOne) SetScore(v int) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ReviewUpsertOne) AddScore(v int) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateScore() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *ReviewUpsertOne) SetText(v string) *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *ReviewUpsertOne) UpdateText() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateText()
+	})
+}
+
+// ClearText clears the value of the "text" field.
+func (u *ReviewUpsertOne) ClearText() *ReviewUpsertOne {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearText()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReviewUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReviewUpsertOne.ID is not supported by MySQL driver. Use ReviewUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReviewUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReviewCreateBulk is the builder for creating many Review entities in bulk.
+type ReviewCreateBulk struct {
+	config
+	err      error
+	builders []*ReviewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Review entities in the database.
+func (rcb *ReviewCreateBulk) Save(ctx context.Context) ([]*Review, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Review, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReviewCreateBulk) SaveX(ctx context.Context) []*Review {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReviewCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Review.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReviewUpsert) {
+//			SetItemID(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ReviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReviewUpsertBulk {
+	rcb.conflict = opts
+	return &ReviewUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ReviewCreateBulk) OnConflictColumns(columns ...string) *ReviewUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ReviewUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ReviewUpsertBulk is the builder for "upsert"-ing
+// a bulk of Review nodes.
+type ReviewUpsertBulk struct {
+	create *ReviewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(review.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReviewUpsertBulk) UpdateNewValues() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(review.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(review.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Review.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReviewUpsertBulk) Ignore() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReviewUpsertBulk) DoNothing() *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReviewCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReviewUpsertBulk) Update(set func(*ReviewUpsert)) *ReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetItemID sets the "item_id" field.
+func (u *ReviewUpsertBulk) SetItemID(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetItemID(v)
+	})
+}
+
+// UpdateItemID sets the "item_id" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateItemID() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateItemID()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *ReviewUpsertBulk) SetAuthor(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateAuthor() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *ReviewUpsertBulk) SetScore(v int) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *ReviewUpsertBulk) AddScore(v int) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateScore() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *ReviewUpsertBulk) SetText(v string) *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *ReviewUpsertBulk) UpdateText() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.UpdateText()
+	})
+}
+
+// ClearText clears the value of the "text" field.
+func (u *ReviewUpsertBulk) ClearText() *ReviewUpsertBulk {
+	return u.Update(func(s *ReviewUpsert) {
+		s.ClearText()
+	})
+}
+
+// Exec executes the query.
+func (u *ReviewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReviewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReviewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReviewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
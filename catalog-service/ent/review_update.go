@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/review"
+)
+
+// ReviewUpdate is the builder for updating Review entities.
+type ReviewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReviewMutation
+}
+
+// Where appends a list predicates to the ReviewUpdate builder.
+func (ru *ReviewUpdate) Where(ps ...predicate.Review) *ReviewUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetItemID sets the "item_id" field.
+func (ru *ReviewUpdate) SetItemID(s string) *ReviewUpdate {
+	ru.mutation.SetItemID(s)
+	return ru
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableItemID(s *string) *ReviewUpdate {
+	if s != nil {
+		ru.SetItemID(*s)
+	}
+	return ru
+}
+
+// SetAuthor sets the "author" field.
+func (ru *ReviewUpdate) SetAuthor(s string) *ReviewUpdate {
+	ru.mutation.SetAuthor(s)
+	return ru
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableAuthor(s *string) *ReviewUpdate {
+	if s != nil {
+		ru.SetAuthor(*s)
+	}
+	return ru
+}
+
+// SetScore sets the "score" field.
+func (ru *ReviewUpdate) SetScore(i int) *ReviewUpdate {
+	ru.mutation.ResetScore()
+	ru.mutation.SetScore(i)
+	return ru
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableScore(i *int) *ReviewUpdate {
+	if i != nil {
+		ru.SetScore(*i)
+	}
+	return ru
+}
+
+// AddScore adds i to the "score" field.
+func (ru *ReviewUpdate) AddScore(i int) *ReviewUpdate {
+	ru.mutation.AddScore(i)
+	return ru
+}
+
+// SetText sets the "text" field.
+func (ru *ReviewUpdate) SetText(s string) *ReviewUpdate {
+	ru.mutation.SetText(s)
+	return ru
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (ru *ReviewUpdate) SetNillableText(s *string) *ReviewUpdate {
+	if s != nil {
+		ru.SetText(*s)
+	}
+	return ru
+}
+
+// ClearText clears the value of the "text" field.
+func (ru *ReviewUpdate) ClearText() *ReviewUpdate {
+	ru.mutation.ClearText()
+	return ru
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (ru *ReviewUpdate) SetItem(i *Item) *ReviewUpdate {
+	return ru.SetItemID(i.ID)
+}
+
+// Mutation returns the ReviewMutation object of the builder.
+func (ru *ReviewUpdate) Mutation() *ReviewMutation {
+	return ru.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (ru *ReviewUpdate) ClearItem() *ReviewUpdate {
+	ru.mutation.ClearItem()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReviewUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReviewUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReviewUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReviewUpdate) check() error {
+	if v, ok := ru.mutation.Author(); ok {
+		if err := review.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "Review.author": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Score(); ok {
+		if err := review.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "Review.score": %w`, err)}
+		}
+	}
+	if ru.mutation.ItemCleared() && len(ru.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Review.item"`)
+	}
+	return nil
+}
+
+func (ru *ReviewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(review.Table, review.Columns, sqlgraph.NewFieldSpec(review.FieldID, field.TypeString))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Author(); ok {
+		_spec.SetField(review.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Score(); ok {
+		_spec.SetField(review.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedScore(); ok {
+		_spec.AddField(review.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.Text(); ok {
+		_spec.SetField(review.FieldText, field.TypeString, value)
+	}
+	if ru.mutation.TextCleared() {
+		_spec.ClearField(review.FieldText, field.TypeString)
+	}
+	if ru.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.ItemTable,
+			Columns: []string{review.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.ItemTable,
+			Columns: []string{review.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{review.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReviewUpdateOne is the builder for updating a single Review entity.
+type ReviewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReviewMutation
+}
+
+// SetItemID sets the "item_id" field.
+func (ruo *ReviewUpdateOne) SetItemID(s string) *ReviewUpdateOne {
+	ruo.mutation.SetItemID(s)
+	return ruo
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableItemID(s *string) *ReviewUpdateOne {
+	if s != nil {
+		ruo.SetItemID(*s)
+	}
+	return ruo
+}
+
+// SetAuthor sets the "author" field.
+func (ruo *ReviewUpdateOne) SetAuthor(s string) *ReviewUpdateOne {
+	ruo.mutation.SetAuthor(s)
+	return ruo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableAuthor(s *string) *ReviewUpdateOne {
+	if s != nil {
+		ruo.SetAuthor(*s)
+	}
+	return ruo
+}
+
+// SetScore sets the "score" field.
+func (ruo *ReviewUpdateOne) SetScore(i int) *ReviewUpdateOne {
+	ruo.mutation.ResetScore()
+	ruo.mutation.SetScore(i)
+	return ruo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableScore(i *int) *ReviewUpdateOne {
+	if i != nil {
+		ruo.SetScore(*i)
+	}
+	return ruo
+}
+
+// AddScore adds i to the "score" field.
+func (ruo *ReviewUpdateOne) AddScore(i int) *ReviewUpdateOne {
+	ruo.mutation.AddScore(i)
+	return ruo
+}
+
+// SetText sets the "text" field.
+func (ruo *ReviewUpdateOne) SetText(s string) *ReviewUpdateOne {
+	ruo.mutation.SetText(s)
+	return ruo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (ruo *ReviewUpdateOne) SetNillableText(s *string) *ReviewUpdateOne {
+	if s != nil {
+		ruo.SetText(*s)
+	}
+	return ruo
+}
+
+// ClearText clears the value of the "text" field.
+func (ruo *ReviewUpdateOne) ClearText() *ReviewUpdateOne {
+	ruo.mutation.ClearText()
+	return ruo
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (ruo *ReviewUpdateOne) SetItem(i *Item) *ReviewUpdateOne {
+	return ruo.SetItemID(i.ID)
+}
+
+// Mutation returns the ReviewMutation object of the builder.
+func (ruo *ReviewUpdateOne) Mutation() *ReviewMutation {
+	return ruo.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (ruo *ReviewUpdateOne) ClearItem() *ReviewUpdateOne {
+	ruo.mutation.ClearItem()
+	return ruo
+}
+
+// Where appends a list predicates to the ReviewUpdate builder.
+func (ruo *ReviewUpdateOne) Where(ps ...predicate.Review) *ReviewUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReviewUpdateOne) Select(field string, fields ...string) *ReviewUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Review entity.
+func (ruo *ReviewUpdateOne) Save(ctx context.Context) (*Review, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReviewUpdateOne) SaveX(ctx context.Context) *Review {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReviewUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReviewUpdateOne) check() error {
+	if v, ok := ruo.mutation.Author(); ok {
+		if err := review.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "Review.author": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Score(); ok {
+		if err := review.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "Review.score": %w`, err)}
+		}
+	}
+	if ruo.mutation.ItemCleared() && len(ruo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Review.item"`)
+	}
+	return nil
+}
+
+func (ruo *ReviewUpdateOne) sqlSave(ctx context.Context) (_node *Review, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(review.Table, review.Columns, sqlgraph.NewFieldSpec(review.FieldID, field.TypeString))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Review.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, review.FieldID)
+		for _, f := range fields {
+			if !review.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != review.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Author(); ok {
+		_spec.SetField(review.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Score(); ok {
+		_spec.SetField(review.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedScore(); ok {
+		_spec.AddField(review.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.Text(); ok {
+		_spec.SetField(review.FieldText, field.TypeString, value)
+	}
+	if ruo.mutation.TextCleared() {
+		_spec.ClearField(review.FieldText, field.TypeString)
+	}
+	if ruo.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.ItemTable,
+			Columns: []string{review.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.ItemTable,
+			Columns: []string{review.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Review{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{review.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
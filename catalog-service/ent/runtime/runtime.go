@@ -2,7 +2,204 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/neokofg/go-pet-microservices/catalog-service/ent/runtime.go
+import (
+	"time"
+
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/apikey"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/category"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemchange"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemrevision"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/outboxevent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/review"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/schema"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[9].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() string)
+	categoryFields := schema.Category{}.Fields()
+	_ = categoryFields
+	// categoryDescName is the schema descriptor for name field.
+	categoryDescName := categoryFields[1].Descriptor()
+	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
+	// categoryDescSlug is the schema descriptor for slug field.
+	categoryDescSlug := categoryFields[2].Descriptor()
+	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
+	// categoryDescPosition is the schema descriptor for position field.
+	categoryDescPosition := categoryFields[5].Descriptor()
+	// category.DefaultPosition holds the default value on creation for the position field.
+	category.DefaultPosition = categoryDescPosition.Default.(int)
+	// categoryDescCreatedAt is the schema descriptor for created_at field.
+	categoryDescCreatedAt := categoryFields[6].Descriptor()
+	// category.DefaultCreatedAt holds the default value on creation for the created_at field.
+	category.DefaultCreatedAt = categoryDescCreatedAt.Default.(func() time.Time)
+	// categoryDescUpdatedAt is the schema descriptor for updated_at field.
+	categoryDescUpdatedAt := categoryFields[7].Descriptor()
+	// category.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
+	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	category.UpdateDefaultUpdatedAt = categoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// categoryDescID is the schema descriptor for id field.
+	categoryDescID := categoryFields[0].Descriptor()
+	// category.DefaultID holds the default value on creation for the id field.
+	category.DefaultID = categoryDescID.Default.(func() string)
+	itemMixin := schema.Item{}.Mixin()
+	itemMixinHooks0 := itemMixin[0].Hooks()
+	itemHooks := schema.Item{}.Hooks()
+	item.Hooks[0] = itemMixinHooks0[0]
+	item.Hooks[1] = itemMixinHooks0[1]
+	item.Hooks[2] = itemHooks[0]
+	item.Hooks[3] = itemHooks[1]
+	item.Hooks[4] = itemHooks[2]
+	itemMixinInters0 := itemMixin[0].Interceptors()
+	item.Interceptors[0] = itemMixinInters0[0]
+	itemFields := schema.Item{}.Fields()
+	_ = itemFields
+	// itemDescTitle is the schema descriptor for title field.
+	itemDescTitle := itemFields[2].Descriptor()
+	// item.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	item.TitleValidator = itemDescTitle.Validators[0].(func(string) error)
+	// itemDescRating is the schema descriptor for rating field.
+	itemDescRating := itemFields[6].Descriptor()
+	// item.DefaultRating holds the default value on creation for the rating field.
+	item.DefaultRating = itemDescRating.Default.(float64)
+	// itemDescReviewCount is the schema descriptor for review_count field.
+	itemDescReviewCount := itemFields[7].Descriptor()
+	// item.DefaultReviewCount holds the default value on creation for the review_count field.
+	item.DefaultReviewCount = itemDescReviewCount.Default.(int)
+	// itemDescVersion is the schema descriptor for version field.
+	itemDescVersion := itemFields[8].Descriptor()
+	// item.DefaultVersion holds the default value on creation for the version field.
+	item.DefaultVersion = itemDescVersion.Default.(int64)
+	// itemDescCreatedAt is the schema descriptor for created_at field.
+	itemDescCreatedAt := itemFields[9].Descriptor()
+	// item.DefaultCreatedAt holds the default value on creation for the created_at field.
+	item.DefaultCreatedAt = itemDescCreatedAt.Default.(func() time.Time)
+	// itemDescUpdatedAt is the schema descriptor for updated_at field.
+	itemDescUpdatedAt := itemFields[10].Descriptor()
+	// item.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	item.DefaultUpdatedAt = itemDescUpdatedAt.Default.(func() time.Time)
+	// item.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	item.UpdateDefaultUpdatedAt = itemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// itemDescID is the schema descriptor for id field.
+	itemDescID := itemFields[0].Descriptor()
+	// item.DefaultID holds the default value on creation for the id field.
+	item.DefaultID = itemDescID.Default.(func() string)
+	itemchangeFields := schema.ItemChange{}.Fields()
+	_ = itemchangeFields
+	// itemchangeDescCreatedAt is the schema descriptor for created_at field.
+	itemchangeDescCreatedAt := itemchangeFields[6].Descriptor()
+	// itemchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemchange.DefaultCreatedAt = itemchangeDescCreatedAt.Default.(func() time.Time)
+	itemrevisionFields := schema.ItemRevision{}.Fields()
+	_ = itemrevisionFields
+	// itemrevisionDescCreatedAt is the schema descriptor for created_at field.
+	itemrevisionDescCreatedAt := itemrevisionFields[9].Descriptor()
+	// itemrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemrevision.DefaultCreatedAt = itemrevisionDescCreatedAt.Default.(func() time.Time)
+	// itemrevisionDescID is the schema descriptor for id field.
+	itemrevisionDescID := itemrevisionFields[0].Descriptor()
+	// itemrevision.DefaultID holds the default value on creation for the id field.
+	itemrevision.DefaultID = itemrevisionDescID.Default.(func() string)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[5].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventFields[0].Descriptor()
+	// outboxevent.DefaultID holds the default value on creation for the id field.
+	outboxevent.DefaultID = outboxeventDescID.Default.(func() string)
+	reviewFields := schema.Review{}.Fields()
+	_ = reviewFields
+	// reviewDescAuthor is the schema descriptor for author field.
+	reviewDescAuthor := reviewFields[2].Descriptor()
+	// review.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	review.AuthorValidator = reviewDescAuthor.Validators[0].(func(string) error)
+	// reviewDescScore is the schema descriptor for score field.
+	reviewDescScore := reviewFields[3].Descriptor()
+	// review.ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	review.ScoreValidator = reviewDescScore.Validators[0].(func(int) error)
+	// reviewDescCreatedAt is the schema descriptor for created_at field.
+	reviewDescCreatedAt := reviewFields[5].Descriptor()
+	// review.DefaultCreatedAt holds the default value on creation for the created_at field.
+	review.DefaultCreatedAt = reviewDescCreatedAt.Default.(func() time.Time)
+	// reviewDescID is the schema descriptor for id field.
+	reviewDescID := reviewFields[0].Descriptor()
+	// review.DefaultID holds the default value on creation for the id field.
+	review.DefaultID = reviewDescID.Default.(func() string)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescKey is the schema descriptor for key field.
+	tagDescKey := tagFields[2].Descriptor()
+	// tag.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	tag.KeyValidator = tagDescKey.Validators[0].(func(string) error)
+	// tagDescUsageCount is the schema descriptor for usage_count field.
+	tagDescUsageCount := tagFields[4].Descriptor()
+	// tag.DefaultUsageCount holds the default value on creation for the usage_count field.
+	tag.DefaultUsageCount = tagDescUsageCount.Default.(int)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[5].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescUpdatedAt is the schema descriptor for updated_at field.
+	tagDescUpdatedAt := tagFields[6].Descriptor()
+	// tag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tag.DefaultUpdatedAt = tagDescUpdatedAt.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tag.UpdateDefaultUpdatedAt = tagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() string)
+	tagsynonymFields := schema.TagSynonym{}.Fields()
+	_ = tagsynonymFields
+	// tagsynonymDescName is the schema descriptor for name field.
+	tagsynonymDescName := tagsynonymFields[2].Descriptor()
+	// tagsynonym.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tagsynonym.NameValidator = tagsynonymDescName.Validators[0].(func(string) error)
+	// tagsynonymDescKey is the schema descriptor for key field.
+	tagsynonymDescKey := tagsynonymFields[3].Descriptor()
+	// tagsynonym.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	tagsynonym.KeyValidator = tagsynonymDescKey.Validators[0].(func(string) error)
+	// tagsynonymDescCreatedAt is the schema descriptor for created_at field.
+	tagsynonymDescCreatedAt := tagsynonymFields[4].Descriptor()
+	// tagsynonym.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tagsynonym.DefaultCreatedAt = tagsynonymDescCreatedAt.Default.(func() time.Time)
+	// tagsynonymDescID is the schema descriptor for id field.
+	tagsynonymDescID := tagsynonymFields[0].Descriptor()
+	// tagsynonym.DefaultID holds the default value on creation for the id field.
+	tagsynonym.DefaultID = tagsynonymDescID.Default.(func() string)
+}
 
 const (
 	Version = "v0.14.1"                                         // Version of ent codegen.
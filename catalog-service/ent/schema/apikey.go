@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("name").
+			NotEmpty(),
+		// Первые символы ключа, чтобы его можно было узнать в списке, не храня целиком
+		field.String("prefix").
+			Immutable(),
+		// SHA-256 от ключа. Сам ключ показывается только один раз при выпуске.
+		field.String("key_hash").
+			Immutable().
+			Sensitive().
+			Unique(),
+		field.Strings("scopes").
+			Optional(),
+		field.String("created_by").
+			Optional().
+			Immutable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return nil
+}
+
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Category holds the schema definition for the Category entity.
+// Categories form a tree through the parent edge; items can belong to several categories.
+type Category struct {
+	ent.Schema
+}
+
+// Fields of the Category.
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("name").
+			NotEmpty(),
+		// slug - уникальный человекочитаемый идентификатор для адресов вида /categories/phones
+		field.String("slug").
+			NotEmpty().
+			Unique(),
+		field.String("description").
+			Optional(),
+		field.String("parent_id").
+			Optional().
+			Nillable(),
+		// position задаёт порядок категорий с общим родителем
+		field.Int("position").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Category.
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Category.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("items", Item.Type),
+	}
+}
+
+func (Category) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_id", "position"),
+	}
+}
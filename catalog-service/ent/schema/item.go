@@ -2,6 +2,9 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
@@ -13,6 +16,13 @@ type Item struct {
 	ent.Schema
 }
 
+// Mixin of the Item.
+func (Item) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Item.
 func (Item) Fields() []ent.Field {
 	return []ent.Field{
@@ -20,6 +30,11 @@ func (Item) Fields() []ent.Field {
 			DefaultFunc(func() string {
 				return uuid.New().String()
 			}),
+		// external_id - ключ элемента во внешней системе, по нему импорт обновляет уже загруженные элементы
+		field.String("external_id").
+			Optional().
+			Nillable().
+			Unique(),
 		field.String("title").
 			NotEmpty(),
 		field.String("description").
@@ -32,6 +47,9 @@ func (Item) Fields() []ent.Field {
 			Default(0),
 		field.Int("review_count").
 			Default(0),
+		// version защищает от потерянных обновлений: каждое изменение редактируемых полей увеличивает его
+		field.Int64("version").
+			Default(1),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
@@ -40,15 +58,34 @@ func (Item) Fields() []ent.Field {
 	}
 }
 
+// Hooks of the Item.
+func (Item) Hooks() []ent.Hook {
+	// Теги приводятся к каноническому виду до подсчёта использования и записи ревизии
+	return []ent.Hook{
+		canonicalizeItemTags,
+		countTagUsage,
+		recordItemRevisions,
+	}
+}
+
 // Edges of the Item.
 func (Item) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("reviews", Review.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("categories", Category.Type).
+			Ref("items"),
+	}
 }
 
 func (Item) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("title"),
-		index.Fields("tags"),
+		// GIN позволяет использовать индекс для операторов @> при фильтрации по тегам
+		index.Fields("tags").
+			Annotations(entsql.IndexTypes(map[string]string{
+				dialect.Postgres: "GIN",
+			})),
 		index.Fields("rating"),
 	}
 }
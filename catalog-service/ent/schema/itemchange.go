@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// ItemChange holds the schema definition for the ItemChange entity.
+// It is the change feed behind WatchItems: the auto-increment id is the sequence
+// number clients resume from after a reconnect.
+type ItemChange struct {
+	ent.Schema
+}
+
+// Fields of the ItemChange.
+func (ItemChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Immutable(),
+		// id события в outbox, одинаковый для брокера и WatchItems
+		field.String("event_id").
+			Immutable(),
+		field.String("event_type").
+			Immutable(),
+		field.String("item_id").
+			Immutable(),
+		// Теги элемента после изменения, по ним фильтруются подписки
+		field.Strings("tags").
+			Optional().
+			Immutable(),
+		// ItemEvent в protojson без sequence
+		field.Bytes("payload").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ItemChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
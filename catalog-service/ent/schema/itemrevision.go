@@ -0,0 +1,205 @@
+package schema
+
+import (
+	"context"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"fmt"
+	"github.com/google/uuid"
+	gen "github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/hook"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemrevision"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/audit"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/logging"
+	"time"
+)
+
+// ItemRevision holds the schema definition for the ItemRevision entity.
+// Revisions are written by the Item hook and are kept after the item is purged.
+type ItemRevision struct {
+	ent.Schema
+}
+
+// Fields of the ItemRevision.
+func (ItemRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}).
+			Immutable(),
+		field.String("item_id").
+			Immutable(),
+		field.Enum("operation").
+			Values("create", "update", "delete", "restore", "purge").
+			Immutable(),
+		field.Int64("item_version").
+			Immutable(),
+		field.JSON("snapshot", audit.Snapshot{}).
+			Immutable(),
+		field.JSON("changes", map[string]audit.Change{}).
+			Optional().
+			Immutable(),
+		field.String("actor").
+			Optional().
+			Immutable(),
+		field.String("request_id").
+			Optional().
+			Immutable(),
+		field.String("reverted_from").
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (ItemRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "created_at"),
+	}
+}
+
+// recordItemRevisions сохраняет ревизию для каждого элемента, затронутого мутацией.
+// Ревизии пишутся тем же клиентом, что и мутация, поэтому внутри транзакции
+// изменение и его ревизия сохраняются или откатываются вместе.
+func recordItemRevisions(next ent.Mutator) ent.Mutator {
+	return hook.ItemFunc(func(ctx context.Context, m *gen.ItemMutation) (gen.Value, error) {
+		if m.Op().Is(ent.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			itm, ok := v.(*gen.Item)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation result %T", v)
+			}
+			return v, saveItemRevisions(ctx, m.Client(), itemrevision.OperationCreate, nil, []*gen.Item{itm})
+		}
+
+		// Изменения только рейтинга и счётчика отзывов не попадают в историю, снимки для них не нужны
+		if !touchesTrackedFields(m) {
+			return next.Mutate(ctx, m)
+		}
+
+		// Снимки читаются и в корзине: удаление, восстановление и очистка работают с удалёнными строками
+		skipCtx := SkipSoftDelete(ctx)
+		ids, err := m.IDs(skipCtx)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return next.Mutate(ctx, m)
+		}
+		before, err := m.Client().Item.Query().Where(item.IDIn(ids...)).All(skipCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+			return v, saveItemRevisions(ctx, m.Client(), itemrevision.OperationPurge, before, nil)
+		}
+
+		after, err := m.Client().Item.Query().Where(item.IDIn(ids...)).All(skipCtx)
+		if err != nil {
+			return nil, err
+		}
+		operation := itemrevision.OperationUpdate
+		switch {
+		case m.DeletedAtCleared():
+			operation = itemrevision.OperationRestore
+		case hasDeletedAt(m):
+			operation = itemrevision.OperationDelete
+		}
+		return v, saveItemRevisions(ctx, m.Client(), operation, before, after)
+	})
+}
+
+func hasDeletedAt(m *gen.ItemMutation) bool {
+	_, ok := m.DeletedAt()
+	return ok
+}
+
+func touchesTrackedFields(m *gen.ItemMutation) bool {
+	if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+		return true
+	}
+	for _, name := range append(m.Fields(), m.ClearedFields()...) {
+		for _, tracked := range audit.TrackedFields {
+			if name == tracked {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// saveItemRevisions сопоставляет состояния до и после мутации по id и пишет ревизии
+// для элементов, у которых изменились отслеживаемые поля
+func saveItemRevisions(ctx context.Context, client *gen.Client, operation itemrevision.Operation, before, after []*gen.Item) error {
+	old := make(map[string]*audit.Snapshot, len(before))
+	for _, itm := range before {
+		old[itm.ID] = itemSnapshot(itm)
+	}
+
+	type revision struct {
+		itemID   string
+		snapshot *audit.Snapshot
+		changes  map[string]audit.Change
+	}
+	var revisions []revision
+	if after == nil {
+		// Окончательное удаление: последним снимком остаётся состояние до удаления
+		for _, itm := range before {
+			revisions = append(revisions, revision{itm.ID, old[itm.ID], audit.Diff(old[itm.ID], nil)})
+		}
+	}
+	for _, itm := range after {
+		snapshot := itemSnapshot(itm)
+		changes := audit.Diff(old[itm.ID], snapshot)
+		if len(changes) == 0 {
+			continue
+		}
+		revisions = append(revisions, revision{itm.ID, snapshot, changes})
+	}
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	actor := audit.ActorFromContext(ctx)
+	requestID := logging.RequestIDFromContext(ctx)
+	revertedFrom := audit.RevertedFromContext(ctx)
+	return client.ItemRevision.MapCreateBulk(revisions, func(c *gen.ItemRevisionCreate, i int) {
+		c.SetItemID(revisions[i].itemID).
+			SetOperation(operation).
+			SetItemVersion(revisions[i].snapshot.Version).
+			SetSnapshot(*revisions[i].snapshot).
+			SetChanges(revisions[i].changes).
+			SetActor(actor).
+			SetRequestID(requestID).
+			SetRevertedFrom(revertedFrom)
+	}).Exec(ctx)
+}
+
+func itemSnapshot(itm *gen.Item) *audit.Snapshot {
+	return &audit.Snapshot{
+		Title:       itm.Title,
+		Description: itm.Description,
+		Tags:        itm.Tags,
+		ImageURL:    itm.ImageURL,
+		Rating:      itm.Rating,
+		ReviewCount: itm.ReviewCount,
+		Version:     itm.Version,
+		CreatedAt:   itm.CreatedAt,
+		UpdatedAt:   itm.UpdatedAt,
+		DeletedAt:   itm.DeletedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+// Events are written in the same transaction as the change they describe
+// and are delivered to the message broker by the outbox relay.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// id передаётся подписчикам как ключ дедупликации
+		field.String("id").
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}).
+			Immutable(),
+		field.String("aggregate_type").
+			Immutable(),
+		field.String("aggregate_id").
+			Immutable(),
+		field.String("event_type").
+			Immutable(),
+		field.Bytes("payload").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("published_at").
+			Optional().
+			Nillable(),
+		field.Int("attempts").
+			Default(0),
+		field.String("last_error").
+			Optional(),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("published_at", "created_at"),
+	}
+}
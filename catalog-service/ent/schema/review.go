@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Review holds the schema definition for the Review entity.
+type Review struct {
+	ent.Schema
+}
+
+// Fields of the Review.
+func (Review) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("item_id"),
+		field.String("author").
+			NotEmpty(),
+		field.Int("score").
+			Range(1, 5),
+		field.Text("text").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Review.
+func (Review) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("reviews").
+			Field("item_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Review) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "created_at"),
+	}
+}
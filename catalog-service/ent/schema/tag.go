@@ -0,0 +1,320 @@
+package schema
+
+import (
+	"context"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"fmt"
+	"github.com/google/uuid"
+	gen "github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/hook"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Tag holds the schema definition for the Tag entity.
+// Items keep tag names in their tags column; the registry maps every spelling
+// and synonym of a tag to one canonical name and counts live items using it.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		// name - каноническое написание, его получают элементы при сохранении
+		field.String("name").
+			NotEmpty().
+			Unique(),
+		// key - имя после TagKey; написания с тем же key считаются одним тегом
+		field.String("key").
+			NotEmpty().
+			Unique(),
+		field.String("description").
+			Optional(),
+		// usage_count - число неудалённых элементов с тегом, его поддерживает хук Item
+		field.Int("usage_count").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("synonyms", TagSynonym.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("usage_count"),
+	}
+}
+
+// TagKey приводит написание тега к ключу для сравнения: регистр, пробелы, дефисы
+// и подчёркивания не различаются, поэтому "Sci-Fi", "sci fi" и "scifi" - один тег
+func TagKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.In(r, unicode.Pd, unicode.Pc) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
+
+// LookupTags находит теги реестра по ключам TagKey, учитывая синонимы
+func LookupTags(ctx context.Context, client *gen.Client, keys []string) (map[string]*gen.Tag, error) {
+	found := make(map[string]*gen.Tag, len(keys))
+	if len(keys) == 0 {
+		return found, nil
+	}
+	tags, err := client.Tag.Query().Where(tag.KeyIn(keys...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		found[t.Key] = t
+	}
+	synonyms, err := client.TagSynonym.Query().
+		Where(tagsynonym.KeyIn(keys...)).
+		WithTag().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range synonyms {
+		if _, ok := found[s.Key]; !ok {
+			found[s.Key] = s.Edges.Tag
+		}
+	}
+	return found, nil
+}
+
+// ResolveTags заменяет написания тегов каноническими именами и убирает повторы.
+// С register неизвестные теги добавляются в реестр в том написании, в котором пришли,
+// без него остаются как есть.
+func ResolveTags(ctx context.Context, client *gen.Client, names []string, register bool) ([]string, error) {
+	keys := make([]string, 0, len(names))
+	spellings := make(map[string]string, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		key := TagKey(name)
+		if key == "" {
+			continue
+		}
+		if _, ok := spellings[key]; !ok {
+			spellings[key] = name
+			keys = append(keys, key)
+		}
+	}
+
+	found, err := LookupTags(ctx, client, keys)
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for _, key := range keys {
+		if _, ok := found[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if register && len(missing) > 0 {
+		// Тот же тег может параллельно регистрировать другой запрос, поэтому конфликт
+		// не ошибка: после вставки теги перечитываются
+		err := client.Tag.MapCreateBulk(missing, func(c *gen.TagCreate, i int) {
+			c.SetName(spellings[missing[i]]).SetKey(missing[i])
+		}).OnConflict().DoNothing().Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		registered, err := LookupTags(ctx, client, missing)
+		if err != nil {
+			return nil, err
+		}
+		for key, t := range registered {
+			found[key] = t
+		}
+	}
+
+	resolved := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		name := spellings[key]
+		if t, ok := found[key]; ok {
+			name = t.Name
+		} else if register {
+			return nil, fmt.Errorf("tag %q was not registered", name)
+		}
+		if !seen[name] {
+			seen[name] = true
+			resolved = append(resolved, name)
+		}
+	}
+	return resolved, nil
+}
+
+// canonicalizeItemTags сохраняет теги элемента в каноническом написании и регистрирует новые.
+// Хук срабатывает при любой записи тегов: создании, обновлении, импорте и откате ревизии.
+func canonicalizeItemTags(next ent.Mutator) ent.Mutator {
+	return hook.ItemFunc(func(ctx context.Context, m *gen.ItemMutation) (gen.Value, error) {
+		tags, ok := m.Tags()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		resolved, err := ResolveTags(ctx, m.Client(), tags, true)
+		if err != nil {
+			return nil, err
+		}
+		m.SetTags(resolved)
+		return next.Mutate(ctx, m)
+	})
+}
+
+// countTagUsage поддерживает usage_count тегов: сравнивает теги неудалённых элементов
+// до и после мутации. Счётчики меняются тем же клиентом, что и элементы.
+func countTagUsage(next ent.Mutator) ent.Mutator {
+	return hook.ItemFunc(func(ctx context.Context, m *gen.ItemMutation) (gen.Value, error) {
+		if m.Op().Is(ent.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+			itm, ok := v.(*gen.Item)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation result %T", v)
+			}
+			return v, adjustTagUsage(ctx, m.Client(), nil, []*gen.Item{itm})
+		}
+		if !touchesTagUsage(m) {
+			return next.Mutate(ctx, m)
+		}
+
+		skipCtx := SkipSoftDelete(ctx)
+		ids, err := m.IDs(skipCtx)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			return next.Mutate(ctx, m)
+		}
+		before, err := m.Client().Item.Query().
+			Where(item.IDIn(ids...)).
+			Select(item.FieldTags, item.FieldDeletedAt).
+			All(skipCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		var after []*gen.Item
+		if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+			after, err = m.Client().Item.Query().
+				Where(item.IDIn(ids...)).
+				Select(item.FieldTags, item.FieldDeletedAt).
+				All(skipCtx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return v, adjustTagUsage(ctx, m.Client(), before, after)
+	})
+}
+
+// touchesTagUsage - меняет ли мутация теги или видимость элементов
+func touchesTagUsage(m *gen.ItemMutation) bool {
+	if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+		return true
+	}
+	for _, name := range append(m.Fields(), m.ClearedFields()...) {
+		if name == item.FieldTags || name == item.FieldDeletedAt {
+			return true
+		}
+	}
+	return false
+}
+
+func adjustTagUsage(ctx context.Context, client *gen.Client, before, after []*gen.Item) error {
+	delta := make(map[string]int)
+	for _, itm := range before {
+		for key := range liveTagKeys(itm) {
+			delta[key]--
+		}
+	}
+	for _, itm := range after {
+		for key := range liveTagKeys(itm) {
+			delta[key]++
+		}
+	}
+	keys := make([]string, 0, len(delta))
+	for key, d := range delta {
+		if d != 0 {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	tags, err := LookupTags(ctx, client, keys)
+	if err != nil {
+		return err
+	}
+	byTag := make(map[string]int, len(tags))
+	for _, key := range keys {
+		// Теги старых элементов могли не попасть в реестр, их учтёт SyncTagRegistry
+		if t, ok := tags[key]; ok {
+			byTag[t.ID] += delta[key]
+		}
+	}
+	// Строки тегов обновляются в порядке id, чтобы параллельные транзакции не блокировали друг друга крест-накрест
+	ids := make([]string, 0, len(byTag))
+	for id, d := range byTag {
+		if d != 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if err := client.Tag.UpdateOneID(id).AddUsageCount(byTag[id]).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// liveTagKeys возвращает ключи тегов неудалённого элемента; удалённые элементы тегов не используют
+func liveTagKeys(itm *gen.Item) map[string]bool {
+	if itm.DeletedAt != nil {
+		return nil
+	}
+	keys := make(map[string]bool, len(itm.Tags))
+	for _, name := range itm.Tags {
+		if key := TagKey(name); key != "" {
+			keys[key] = true
+		}
+	}
+	return keys
+}
@@ -0,0 +1,28 @@
+package schema
+
+import "testing"
+
+func TestTagKey(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"scifi", "scifi"},
+		{"Sci-Fi", "scifi"},
+		{"sci fi", "scifi"},
+		{"SCI_FI", "scifi"},
+		{" sci\tfi ", "scifi"},
+		{"sci–fi", "scifi"},
+		{"Научная фантастика", "научнаяфантастика"},
+		{"c++", "c++"},
+		{"4.5", "4.5"},
+		{"- _", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TagKey(tt.name); got != tt.want {
+				t.Errorf("TagKey(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
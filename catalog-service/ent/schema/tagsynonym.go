@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"time"
+)
+
+// TagSynonym holds the schema definition for the TagSynonym entity.
+// A synonym is an alternative name that resolves to its tag, e.g. "science fiction" for "Sci-Fi".
+type TagSynonym struct {
+	ent.Schema
+}
+
+// Fields of the TagSynonym.
+func (TagSynonym) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("tag_id"),
+		// name - написание, в котором синоним добавили, key - то же после TagKey
+		field.String("name").
+			NotEmpty(),
+		field.String("key").
+			NotEmpty().
+			Unique(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TagSynonym.
+func (TagSynonym) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tag", Tag.Type).
+			Ref("synonyms").
+			Field("tag_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
+)
+
+// TagSynonym is the model entity for the TagSynonym schema.
+type TagSynonym struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID string `json:"tag_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TagSynonymQuery when eager-loading is set.
+	Edges        TagSynonymEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TagSynonymEdges holds the relations/edges for other nodes in the graph.
+type TagSynonymEdges struct {
+	// Tag holds the value of the tag edge.
+	Tag *Tag `json:"tag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TagOrErr returns the Tag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagSynonymEdges) TagOrErr() (*Tag, error) {
+	if e.Tag != nil {
+		return e.Tag, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "tag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TagSynonym) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tagsynonym.FieldID, tagsynonym.FieldTagID, tagsynonym.FieldName, tagsynonym.FieldKey:
+			values[i] = new(sql.NullString)
+		case tagsynonym.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TagSynonym fields.
+func (ts *TagSynonym) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tagsynonym.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ts.ID = value.String
+			}
+		case tagsynonym.FieldTagID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				ts.TagID = value.String
+			}
+		case tagsynonym.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ts.Name = value.String
+			}
+		case tagsynonym.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ts.Key = value.String
+			}
+		case tagsynonym.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ts.CreatedAt = value.Time
+			}
+		default:
+			ts.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TagSynonym.
+// This includes values selected through modifiers, order, etc.
+func (ts *TagSynonym) Value(name string) (ent.Value, error) {
+	return ts.selectValues.Get(name)
+}
+
+// QueryTag queries the "tag" edge of the TagSynonym entity.
+func (ts *TagSynonym) QueryTag() *TagQuery {
+	return NewTagSynonymClient(ts.config).QueryTag(ts)
+}
+
+// Update returns a builder for updating this TagSynonym.
+// Note that you need to call TagSynonym.Unwrap() before calling this method if this TagSynonym
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ts *TagSynonym) Update() *TagSynonymUpdateOne {
+	return NewTagSynonymClient(ts.config).UpdateOne(ts)
+}
+
+// Unwrap unwraps the TagSynonym entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ts *TagSynonym) Unwrap() *TagSynonym {
+	_tx, ok := ts.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TagSynonym is not a transactional entity")
+	}
+	ts.config.driver = _tx.drv
+	return ts
+}
+
+// String implements the fmt.Stringer.
+func (ts *TagSynonym) String() string {
+	var builder strings.Builder
+	builder.WriteString("TagSynonym(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ts.ID))
+	builder.WriteString("tag_id=")
+	builder.WriteString(ts.TagID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ts.Name)
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(ts.Key)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ts.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TagSynonyms is a parsable slice of TagSynonym.
+type TagSynonyms []*TagSynonym
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tagsynonym
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContainsFold(FieldID, id))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldTagID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldName, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// TagIDGT applies the GT predicate on the "tag_id" field.
+func TagIDGT(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldTagID, v))
+}
+
+// TagIDGTE applies the GTE predicate on the "tag_id" field.
+func TagIDGTE(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldTagID, v))
+}
+
+// TagIDLT applies the LT predicate on the "tag_id" field.
+func TagIDLT(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldTagID, v))
+}
+
+// TagIDLTE applies the LTE predicate on the "tag_id" field.
+func TagIDLTE(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldTagID, v))
+}
+
+// TagIDContains applies the Contains predicate on the "tag_id" field.
+func TagIDContains(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContains(FieldTagID, v))
+}
+
+// TagIDHasPrefix applies the HasPrefix predicate on the "tag_id" field.
+func TagIDHasPrefix(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldHasPrefix(FieldTagID, v))
+}
+
+// TagIDHasSuffix applies the HasSuffix predicate on the "tag_id" field.
+func TagIDHasSuffix(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldHasSuffix(FieldTagID, v))
+}
+
+// TagIDEqualFold applies the EqualFold predicate on the "tag_id" field.
+func TagIDEqualFold(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEqualFold(FieldTagID, v))
+}
+
+// TagIDContainsFold applies the ContainsFold predicate on the "tag_id" field.
+func TagIDContainsFold(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContainsFold(FieldTagID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContainsFold(FieldName, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldContainsFold(FieldKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasTag applies the HasEdge predicate on the "tag" edge.
+func HasTag() predicate.TagSynonym {
+	return predicate.TagSynonym(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TagTable, TagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagWith applies the HasEdge predicate on the "tag" edge with a given conditions (other predicates).
+func HasTagWith(preds ...predicate.Tag) predicate.TagSynonym {
+	return predicate.TagSynonym(func(s *sql.Selector) {
+		step := newTagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TagSynonym) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TagSynonym) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TagSynonym) predicate.TagSynonym {
+	return predicate.TagSynonym(sql.NotPredicates(p))
+}
@@ -0,0 +1,677 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
+)
+
+// TagSynonymCreate is the builder for creating a TagSynonym entity.
+type TagSynonymCreate struct {
+	config
+	mutation *TagSynonymMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTagID sets the "tag_id" field.
+func (tsc *TagSynonymCreate) SetTagID(s string) *TagSynonymCreate {
+	tsc.mutation.SetTagID(s)
+	return tsc
+}
+
+// SetName sets the "name" field.
+func (tsc *TagSynonymCreate) SetName(s string) *TagSynonymCreate {
+	tsc.mutation.SetName(s)
+	return tsc
+}
+
+// SetKey sets the "key" field.
+func (tsc *TagSynonymCreate) SetKey(s string) *TagSynonymCreate {
+	tsc.mutation.SetKey(s)
+	return tsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tsc *TagSynonymCreate) SetCreatedAt(t time.Time) *TagSynonymCreate {
+	tsc.mutation.SetCreatedAt(t)
+	return tsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tsc *TagSynonymCreate) SetNillableCreatedAt(t *time.Time) *TagSynonymCreate {
+	if t != nil {
+		tsc.SetCreatedAt(*t)
+	}
+	return tsc
+}
+
+// SetID sets the "id" field.
+func (tsc *TagSynonymCreate) SetID(s string) *TagSynonymCreate {
+	tsc.mutation.SetID(s)
+	return tsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tsc *TagSynonymCreate) SetNillableID(s *string) *TagSynonymCreate {
+	if s != nil {
+		tsc.SetID(*s)
+	}
+	return tsc
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tsc *TagSynonymCreate) SetTag(t *Tag) *TagSynonymCreate {
+	return tsc.SetTagID(t.ID)
+}
+
+// Mutation returns the TagSynonymMutation object of the builder.
+func (tsc *TagSynonymCreate) Mutation() *TagSynonymMutation {
+	return tsc.mutation
+}
+
+// Save creates the TagSynonym in the database.
+func (tsc *TagSynonymCreate) Save(ctx context.Context) (*TagSynonym, error) {
+	tsc.defaults()
+	return withHooks(ctx, tsc.sqlSave, tsc.mutation, tsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tsc *TagSynonymCreate) SaveX(ctx context.Context) *TagSynonym {
+	v, err := tsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsc *TagSynonymCreate) Exec(ctx context.Context) error {
+	_, err := tsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsc *TagSynonymCreate) ExecX(ctx context.Context) {
+	if err := tsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tsc *TagSynonymCreate) defaults() {
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		v := tagsynonym.DefaultCreatedAt()
+		tsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tsc.mutation.ID(); !ok {
+		v := tagsynonym.DefaultID()
+		tsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsc *TagSynonymCreate) check() error {
+	if _, ok := tsc.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "TagSynonym.tag_id"`)}
+	}
+	if _, ok := tsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TagSynonym.name"`)}
+	}
+	if v, ok := tsc.mutation.Name(); ok {
+		if err := tagsynonym.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagSynonym.name": %w`, err)}
+		}
+	}
+	if _, ok := tsc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "TagSynonym.key"`)}
+	}
+	if v, ok := tsc.mutation.Key(); ok {
+		if err := tagsynonym.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "TagSynonym.key": %w`, err)}
+		}
+	}
+	if _, ok := tsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TagSynonym.created_at"`)}
+	}
+	if len(tsc.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TagSynonym.tag"`)}
+	}
+	return nil
+}
+
+func (tsc *TagSynonymCreate) sqlSave(ctx context.Context) (*TagSynonym, error) {
+	if err := tsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TagSynonym.ID type: %T", _spec.ID.Value)
+		}
+	}
+	tsc.mutation.id = &_node.ID
+	tsc.mutation.done = true
+	return _node, nil
+}
+
+func (tsc *TagSynonymCreate) createSpec() (*TagSynonym, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagSynonym{config: tsc.config}
+		_spec = sqlgraph.NewCreateSpec(tagsynonym.Table, sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = tsc.conflict
+	if id, ok := tsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tsc.mutation.Name(); ok {
+		_spec.SetField(tagsynonym.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tsc.mutation.Key(); ok {
+		_spec.SetField(tagsynonym.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := tsc.mutation.CreatedAt(); ok {
+		_spec.SetField(tagsynonym.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tsc.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagSynonym.Create().
+//		SetTagID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagSynonymUpsert) {
+//			SetTagID(v+v).
+//		}).
+//		Exec(ctx)
+func (tsc *TagSynonymCreate) OnConflict(opts ...sql.ConflictOption) *TagSynonymUpsertOne {
+	tsc.conflict = opts
+	return &TagSynonymUpsertOne{
+		create: tsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tsc *TagSynonymCreate) OnConflictColumns(columns ...string) *TagSynonymUpsertOne {
+	tsc.conflict = append(tsc.conflict, sql.ConflictColumns(columns...))
+	return &TagSynonymUpsertOne{
+		create: tsc,
+	}
+}
+
+type (
+	// TagSynonymUpsertOne is the builder for "upsert"-ing
+	//  one TagSynonym node.
+	TagSynonymUpsertOne struct {
+		create *TagSynonymCreate
+	}
+
+	// TagSynonymUpsert is the "OnConflict" setter.
+	TagSynonymUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTagID sets the "tag_id" field.
+func (u *TagSynonymUpsert) SetTagID(v string) *TagSynonymUpsert {
+	u.Set(tagsynonym.FieldTagID, v)
+	return u
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagSynonymUpsert) UpdateTagID() *TagSynonymUpsert {
+	u.SetExcluded(tagsynonym.FieldTagID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TagSynonymUpsert) SetName(v string) *TagSynonymUpsert {
+	u.Set(tagsynonym.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSynonymUpsert) UpdateName() *TagSynonymUpsert {
+	u.SetExcluded(tagsynonym.FieldName)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *TagSynonymUpsert) SetKey(v string) *TagSynonymUpsert {
+	u.Set(tagsynonym.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *TagSynonymUpsert) UpdateKey() *TagSynonymUpsert {
+	u.SetExcluded(tagsynonym.FieldKey)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tagsynonym.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TagSynonymUpsertOne) UpdateNewValues() *TagSynonymUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tagsynonym.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tagsynonym.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TagSynonymUpsertOne) Ignore() *TagSynonymUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagSynonymUpsertOne) DoNothing() *TagSynonymUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagSynonymCreate.OnConflict
+// documentation for more info.
+func (u *TagSynonymUpsertOne) Update(set func(*TagSynonymUpsert)) *TagSynonymUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagSynonymUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagSynonymUpsertOne) SetTagID(v string) *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagSynonymUpsertOne) UpdateTagID() *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TagSynonymUpsertOne) SetName(v string) *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSynonymUpsertOne) UpdateName() *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *TagSynonymUpsertOne) SetKey(v string) *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *TagSynonymUpsertOne) UpdateKey() *TagSynonymUpsertOne {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// Exec executes the query.
+func (u *TagSynonymUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagSynonymCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagSynonymUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TagSynonymUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TagSynonymUpsertOne.ID is not supported by MySQL driver. Use TagSynonymUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TagSynonymUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TagSynonymCreateBulk is the builder for creating many TagSynonym entities in bulk.
+type TagSynonymCreateBulk struct {
+	config
+	err      error
+	builders []*TagSynonymCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TagSynonym entities in the database.
+func (tscb *TagSynonymCreateBulk) Save(ctx context.Context) ([]*TagSynonym, error) {
+	if tscb.err != nil {
+		return nil, tscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tscb.builders))
+	nodes := make([]*TagSynonym, len(tscb.builders))
+	mutators := make([]Mutator, len(tscb.builders))
+	for i := range tscb.builders {
+		func(i int, root context.Context) {
+			builder := tscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagSynonymMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscb *TagSynonymCreateBulk) SaveX(ctx context.Context) []*TagSynonym {
+	v, err := tscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tscb *TagSynonymCreateBulk) Exec(ctx context.Context) error {
+	_, err := tscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscb *TagSynonymCreateBulk) ExecX(ctx context.Context) {
+	if err := tscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TagSynonym.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TagSynonymUpsert) {
+//			SetTagID(v+v).
+//		}).
+//		Exec(ctx)
+func (tscb *TagSynonymCreateBulk) OnConflict(opts ...sql.ConflictOption) *TagSynonymUpsertBulk {
+	tscb.conflict = opts
+	return &TagSynonymUpsertBulk{
+		create: tscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tscb *TagSynonymCreateBulk) OnConflictColumns(columns ...string) *TagSynonymUpsertBulk {
+	tscb.conflict = append(tscb.conflict, sql.ConflictColumns(columns...))
+	return &TagSynonymUpsertBulk{
+		create: tscb,
+	}
+}
+
+// TagSynonymUpsertBulk is the builder for "upsert"-ing
+// a bulk of TagSynonym nodes.
+type TagSynonymUpsertBulk struct {
+	create *TagSynonymCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tagsynonym.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TagSynonymUpsertBulk) UpdateNewValues() *TagSynonymUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tagsynonym.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tagsynonym.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TagSynonym.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TagSynonymUpsertBulk) Ignore() *TagSynonymUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TagSynonymUpsertBulk) DoNothing() *TagSynonymUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TagSynonymCreateBulk.OnConflict
+// documentation for more info.
+func (u *TagSynonymUpsertBulk) Update(set func(*TagSynonymUpsert)) *TagSynonymUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TagSynonymUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTagID sets the "tag_id" field.
+func (u *TagSynonymUpsertBulk) SetTagID(v string) *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetTagID(v)
+	})
+}
+
+// UpdateTagID sets the "tag_id" field to the value that was provided on create.
+func (u *TagSynonymUpsertBulk) UpdateTagID() *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateTagID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TagSynonymUpsertBulk) SetName(v string) *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TagSynonymUpsertBulk) UpdateName() *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *TagSynonymUpsertBulk) SetKey(v string) *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *TagSynonymUpsertBulk) UpdateKey() *TagSynonymUpsertBulk {
+	return u.Update(func(s *TagSynonymUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// Exec executes the query.
+func (u *TagSynonymUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TagSynonymCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TagSynonymCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TagSynonymUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
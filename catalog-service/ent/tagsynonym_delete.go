@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
+)
+
+// TagSynonymDelete is the builder for deleting a TagSynonym entity.
+type TagSynonymDelete struct {
+	config
+	hooks    []Hook
+	mutation *TagSynonymMutation
+}
+
+// Where appends a list predicates to the TagSynonymDelete builder.
+func (tsd *TagSynonymDelete) Where(ps ...predicate.TagSynonym) *TagSynonymDelete {
+	tsd.mutation.Where(ps...)
+	return tsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tsd *TagSynonymDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsd.sqlExec, tsd.mutation, tsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsd *TagSynonymDelete) ExecX(ctx context.Context) int {
+	n, err := tsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tsd *TagSynonymDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tagsynonym.Table, sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString))
+	if ps := tsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tsd.mutation.done = true
+	return affected, err
+}
+
+// TagSynonymDeleteOne is the builder for deleting a single TagSynonym entity.
+type TagSynonymDeleteOne struct {
+	tsd *TagSynonymDelete
+}
+
+// Where appends a list predicates to the TagSynonymDelete builder.
+func (tsdo *TagSynonymDeleteOne) Where(ps ...predicate.TagSynonym) *TagSynonymDeleteOne {
+	tsdo.tsd.mutation.Where(ps...)
+	return tsdo
+}
+
+// Exec executes the deletion query.
+func (tsdo *TagSynonymDeleteOne) Exec(ctx context.Context) error {
+	n, err := tsdo.tsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tagsynonym.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsdo *TagSynonymDeleteOne) ExecX(ctx context.Context) {
+	if err := tsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
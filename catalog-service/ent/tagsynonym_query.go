@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
+)
+
+// TagSynonymQuery is the builder for querying TagSynonym entities.
+type TagSynonymQuery struct {
+	config
+	ctx        *QueryContext
+	order      []tagsynonym.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TagSynonym
+	withTag    *TagQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TagSynonymQuery builder.
+func (tsq *TagSynonymQuery) Where(ps ...predicate.TagSynonym) *TagSynonymQuery {
+	tsq.predicates = append(tsq.predicates, ps...)
+	return tsq
+}
+
+// Limit the number of records to be returned by this query.
+func (tsq *TagSynonymQuery) Limit(limit int) *TagSynonymQuery {
+	tsq.ctx.Limit = &limit
+	return tsq
+}
+
+// Offset to start from.
+func (tsq *TagSynonymQuery) Offset(offset int) *TagSynonymQuery {
+	tsq.ctx.Offset = &offset
+	return tsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tsq *TagSynonymQuery) Unique(unique bool) *TagSynonymQuery {
+	tsq.ctx.Unique = &unique
+	return tsq
+}
+
+// Order specifies how the records should be ordered.
+func (tsq *TagSynonymQuery) Order(o ...tagsynonym.OrderOption) *TagSynonymQuery {
+	tsq.order = append(tsq.order, o...)
+	return tsq
+}
+
+// QueryTag chains the current query on the "tag" edge.
+func (tsq *TagSynonymQuery) QueryTag() *TagQuery {
+	query := (&TagClient{config: tsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagsynonym.Table, tagsynonym.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tagsynonym.TagTable, tagsynonym.TagColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TagSynonym entity from the query.
+// Returns a *NotFoundError when no TagSynonym was found.
+func (tsq *TagSynonymQuery) First(ctx context.Context) (*TagSynonym, error) {
+	nodes, err := tsq.Limit(1).All(setContextOp(ctx, tsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tagsynonym.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tsq *TagSynonymQuery) FirstX(ctx context.Context) *TagSynonym {
+	node, err := tsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TagSynonym ID from the query.
+// Returns a *NotFoundError when no TagSynonym ID was found.
+func (tsq *TagSynonymQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tsq.Limit(1).IDs(setContextOp(ctx, tsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tagsynonym.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tsq *TagSynonymQuery) FirstIDX(ctx context.Context) string {
+	id, err := tsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TagSynonym entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TagSynonym entity is found.
+// Returns a *NotFoundError when no TagSynonym entities are found.
+func (tsq *TagSynonymQuery) Only(ctx context.Context) (*TagSynonym, error) {
+	nodes, err := tsq.Limit(2).All(setContextOp(ctx, tsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tagsynonym.Label}
+	default:
+		return nil, &NotSingularError{tagsynonym.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tsq *TagSynonymQuery) OnlyX(ctx context.Context) *TagSynonym {
+	node, err := tsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TagSynonym ID in the query.
+// Returns a *NotSingularError when more than one TagSynonym ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tsq *TagSynonymQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tsq.Limit(2).IDs(setContextOp(ctx, tsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tagsynonym.Label}
+	default:
+		err = &NotSingularError{tagsynonym.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tsq *TagSynonymQuery) OnlyIDX(ctx context.Context) string {
+	id, err := tsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TagSynonyms.
+func (tsq *TagSynonymQuery) All(ctx context.Context) ([]*TagSynonym, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryAll)
+	if err := tsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TagSynonym, *TagSynonymQuery]()
+	return withInterceptors[[]*TagSynonym](ctx, tsq, qr, tsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tsq *TagSynonymQuery) AllX(ctx context.Context) []*TagSynonym {
+	nodes, err := tsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TagSynonym IDs.
+func (tsq *TagSynonymQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if tsq.ctx.Unique == nil && tsq.path != nil {
+		tsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryIDs)
+	if err = tsq.Select(tagsynonym.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tsq *TagSynonymQuery) IDsX(ctx context.Context) []string {
+	ids, err := tsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tsq *TagSynonymQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryCount)
+	if err := tsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tsq, querierCount[*TagSynonymQuery](), tsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tsq *TagSynonymQuery) CountX(ctx context.Context) int {
+	count, err := tsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tsq *TagSynonymQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tsq.ctx, ent.OpQueryExist)
+	switch _, err := tsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tsq *TagSynonymQuery) ExistX(ctx context.Context) bool {
+	exist, err := tsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TagSynonymQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tsq *TagSynonymQuery) Clone() *TagSynonymQuery {
+	if tsq == nil {
+		return nil
+	}
+	return &TagSynonymQuery{
+		config:     tsq.config,
+		ctx:        tsq.ctx.Clone(),
+		order:      append([]tagsynonym.OrderOption{}, tsq.order...),
+		inters:     append([]Interceptor{}, tsq.inters...),
+		predicates: append([]predicate.TagSynonym{}, tsq.predicates...),
+		withTag:    tsq.withTag.Clone(),
+		// clone intermediate query.
+		sql:  tsq.sql.Clone(),
+		path: tsq.path,
+	}
+}
+
+// WithTag tells the query-builder to eager-load the nodes that are connected to
+// the "tag" edge. The optional arguments are used to configure the query builder of the edge.
+func (tsq *TagSynonymQuery) WithTag(opts ...func(*TagQuery)) *TagSynonymQuery {
+	query := (&TagClient{config: tsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tsq.withTag = query
+	return tsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TagID string `json:"tag_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TagSynonym.Query().
+//		GroupBy(tagsynonym.FieldTagID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tsq *TagSynonymQuery) GroupBy(field string, fields ...string) *TagSynonymGroupBy {
+	tsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TagSynonymGroupBy{build: tsq}
+	grbuild.flds = &tsq.ctx.Fields
+	grbuild.label = tagsynonym.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TagID string `json:"tag_id,omitempty"`
+//	}
+//
+//	client.TagSynonym.Query().
+//		Select(tagsynonym.FieldTagID).
+//		Scan(ctx, &v)
+func (tsq *TagSynonymQuery) Select(fields ...string) *TagSynonymSelect {
+	tsq.ctx.Fields = append(tsq.ctx.Fields, fields...)
+	sbuild := &TagSynonymSelect{TagSynonymQuery: tsq}
+	sbuild.label = tagsynonym.Label
+	sbuild.flds, sbuild.scan = &tsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TagSynonymSelect configured with the given aggregations.
+func (tsq *TagSynonymQuery) Aggregate(fns ...AggregateFunc) *TagSynonymSelect {
+	return tsq.Select().Aggregate(fns...)
+}
+
+func (tsq *TagSynonymQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tsq.ctx.Fields {
+		if !tagsynonym.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tsq.path != nil {
+		prev, err := tsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tsq.sql = prev
+	}
+	return nil
+}
+
+func (tsq *TagSynonymQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TagSynonym, error) {
+	var (
+		nodes       = []*TagSynonym{}
+		_spec       = tsq.querySpec()
+		loadedTypes = [1]bool{
+			tsq.withTag != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TagSynonym).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TagSynonym{config: tsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tsq.modifiers) > 0 {
+		_spec.Modifiers = tsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tsq.withTag; query != nil {
+		if err := tsq.loadTag(ctx, query, nodes, nil,
+			func(n *TagSynonym, e *Tag) { n.Edges.Tag = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tsq *TagSynonymQuery) loadTag(ctx context.Context, query *TagQuery, nodes []*TagSynonym, init func(*TagSynonym), assign func(*TagSynonym, *Tag)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*TagSynonym)
+	for i := range nodes {
+		fk := nodes[i].TagID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tag.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tag_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tsq *TagSynonymQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tsq.querySpec()
+	if len(tsq.modifiers) > 0 {
+		_spec.Modifiers = tsq.modifiers
+	}
+	_spec.Node.Columns = tsq.ctx.Fields
+	if len(tsq.ctx.Fields) > 0 {
+		_spec.Unique = tsq.ctx.Unique != nil && *tsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tsq.driver, _spec)
+}
+
+func (tsq *TagSynonymQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tagsynonym.Table, tagsynonym.Columns, sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString))
+	_spec.From = tsq.sql
+	if unique := tsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagsynonym.FieldID)
+		for i := range fields {
+			if fields[i] != tagsynonym.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tsq.withTag != nil {
+			_spec.Node.AddColumnOnce(tagsynonym.FieldTagID)
+		}
+	}
+	if ps := tsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tsq *TagSynonymQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tsq.driver.Dialect())
+	t1 := builder.Table(tagsynonym.Table)
+	columns := tsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tagsynonym.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tsq.sql != nil {
+		selector = tsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tsq.ctx.Unique != nil && *tsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tsq.modifiers {
+		m(selector)
+	}
+	for _, p := range tsq.predicates {
+		p(selector)
+	}
+	for _, p := range tsq.order {
+		p(selector)
+	}
+	if offset := tsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tsq *TagSynonymQuery) ForUpdate(opts ...sql.LockOption) *TagSynonymQuery {
+	if tsq.driver.Dialect() == dialect.Postgres {
+		tsq.Unique(false)
+	}
+	tsq.modifiers = append(tsq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tsq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tsq *TagSynonymQuery) ForShare(opts ...sql.LockOption) *TagSynonymQuery {
+	if tsq.driver.Dialect() == dialect.Postgres {
+		tsq.Unique(false)
+	}
+	tsq.modifiers = append(tsq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tsq
+}
+
+// TagSynonymGroupBy is the group-by builder for TagSynonym entities.
+type TagSynonymGroupBy struct {
+	selector
+	build *TagSynonymQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tsgb *TagSynonymGroupBy) Aggregate(fns ...AggregateFunc) *TagSynonymGroupBy {
+	tsgb.fns = append(tsgb.fns, fns...)
+	return tsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tsgb *TagSynonymGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TagSynonymQuery, *TagSynonymGroupBy](ctx, tsgb.build, tsgb, tsgb.build.inters, v)
+}
+
+func (tsgb *TagSynonymGroupBy) sqlScan(ctx context.Context, root *TagSynonymQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tsgb.fns))
+	for _, fn := range tsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tsgb.flds)+len(tsgb.fns))
+		for _, f := range *tsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TagSynonymSelect is the builder for selecting fields of TagSynonym entities.
+type TagSynonymSelect struct {
+	*TagSynonymQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tss *TagSynonymSelect) Aggregate(fns ...AggregateFunc) *TagSynonymSelect {
+	tss.fns = append(tss.fns, fns...)
+	return tss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tss *TagSynonymSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tss.ctx, ent.OpQuerySelect)
+	if err := tss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TagSynonymQuery, *TagSynonymSelect](ctx, tss.TagSynonymQuery, tss, tss.inters, v)
+}
+
+func (tss *TagSynonymSelect) sqlScan(ctx context.Context, root *TagSynonymQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tss.fns))
+	for _, fn := range tss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
+)
+
+// TagSynonymUpdate is the builder for updating TagSynonym entities.
+type TagSynonymUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TagSynonymMutation
+}
+
+// Where appends a list predicates to the TagSynonymUpdate builder.
+func (tsu *TagSynonymUpdate) Where(ps ...predicate.TagSynonym) *TagSynonymUpdate {
+	tsu.mutation.Where(ps...)
+	return tsu
+}
+
+// SetTagID sets the "tag_id" field.
+func (tsu *TagSynonymUpdate) SetTagID(s string) *TagSynonymUpdate {
+	tsu.mutation.SetTagID(s)
+	return tsu
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (tsu *TagSynonymUpdate) SetNillableTagID(s *string) *TagSynonymUpdate {
+	if s != nil {
+		tsu.SetTagID(*s)
+	}
+	return tsu
+}
+
+// SetName sets the "name" field.
+func (tsu *TagSynonymUpdate) SetName(s string) *TagSynonymUpdate {
+	tsu.mutation.SetName(s)
+	return tsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tsu *TagSynonymUpdate) SetNillableName(s *string) *TagSynonymUpdate {
+	if s != nil {
+		tsu.SetName(*s)
+	}
+	return tsu
+}
+
+// SetKey sets the "key" field.
+func (tsu *TagSynonymUpdate) SetKey(s string) *TagSynonymUpdate {
+	tsu.mutation.SetKey(s)
+	return tsu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (tsu *TagSynonymUpdate) SetNillableKey(s *string) *TagSynonymUpdate {
+	if s != nil {
+		tsu.SetKey(*s)
+	}
+	return tsu
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tsu *TagSynonymUpdate) SetTag(t *Tag) *TagSynonymUpdate {
+	return tsu.SetTagID(t.ID)
+}
+
+// Mutation returns the TagSynonymMutation object of the builder.
+func (tsu *TagSynonymUpdate) Mutation() *TagSynonymMutation {
+	return tsu.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (tsu *TagSynonymUpdate) ClearTag() *TagSynonymUpdate {
+	tsu.mutation.ClearTag()
+	return tsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tsu *TagSynonymUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tsu.sqlSave, tsu.mutation, tsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsu *TagSynonymUpdate) SaveX(ctx context.Context) int {
+	affected, err := tsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tsu *TagSynonymUpdate) Exec(ctx context.Context) error {
+	_, err := tsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsu *TagSynonymUpdate) ExecX(ctx context.Context) {
+	if err := tsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsu *TagSynonymUpdate) check() error {
+	if v, ok := tsu.mutation.Name(); ok {
+		if err := tagsynonym.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagSynonym.name": %w`, err)}
+		}
+	}
+	if v, ok := tsu.mutation.Key(); ok {
+		if err := tagsynonym.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "TagSynonym.key": %w`, err)}
+		}
+	}
+	if tsu.mutation.TagCleared() && len(tsu.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagSynonym.tag"`)
+	}
+	return nil
+}
+
+func (tsu *TagSynonymUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagsynonym.Table, tagsynonym.Columns, sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString))
+	if ps := tsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsu.mutation.Name(); ok {
+		_spec.SetField(tagsynonym.FieldName, field.TypeString, value)
+	}
+	if value, ok := tsu.mutation.Key(); ok {
+		_spec.SetField(tagsynonym.FieldKey, field.TypeString, value)
+	}
+	if tsu.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsu.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagsynonym.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tsu.mutation.done = true
+	return n, nil
+}
+
+// TagSynonymUpdateOne is the builder for updating a single TagSynonym entity.
+type TagSynonymUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TagSynonymMutation
+}
+
+// SetTagID sets the "tag_id" field.
+func (tsuo *TagSynonymUpdateOne) SetTagID(s string) *TagSynonymUpdateOne {
+	tsuo.mutation.SetTagID(s)
+	return tsuo
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (tsuo *TagSynonymUpdateOne) SetNillableTagID(s *string) *TagSynonymUpdateOne {
+	if s != nil {
+		tsuo.SetTagID(*s)
+	}
+	return tsuo
+}
+
+// SetName sets the "name" field.
+func (tsuo *TagSynonymUpdateOne) SetName(s string) *TagSynonymUpdateOne {
+	tsuo.mutation.SetName(s)
+	return tsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tsuo *TagSynonymUpdateOne) SetNillableName(s *string) *TagSynonymUpdateOne {
+	if s != nil {
+		tsuo.SetName(*s)
+	}
+	return tsuo
+}
+
+// SetKey sets the "key" field.
+func (tsuo *TagSynonymUpdateOne) SetKey(s string) *TagSynonymUpdateOne {
+	tsuo.mutation.SetKey(s)
+	return tsuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (tsuo *TagSynonymUpdateOne) SetNillableKey(s *string) *TagSynonymUpdateOne {
+	if s != nil {
+		tsuo.SetKey(*s)
+	}
+	return tsuo
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (tsuo *TagSynonymUpdateOne) SetTag(t *Tag) *TagSynonymUpdateOne {
+	return tsuo.SetTagID(t.ID)
+}
+
+// Mutation returns the TagSynonymMutation object of the builder.
+func (tsuo *TagSynonymUpdateOne) Mutation() *TagSynonymMutation {
+	return tsuo.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (tsuo *TagSynonymUpdateOne) ClearTag() *TagSynonymUpdateOne {
+	tsuo.mutation.ClearTag()
+	return tsuo
+}
+
+// Where appends a list predicates to the TagSynonymUpdate builder.
+func (tsuo *TagSynonymUpdateOne) Where(ps ...predicate.TagSynonym) *TagSynonymUpdateOne {
+	tsuo.mutation.Where(ps...)
+	return tsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tsuo *TagSynonymUpdateOne) Select(field string, fields ...string) *TagSynonymUpdateOne {
+	tsuo.fields = append([]string{field}, fields...)
+	return tsuo
+}
+
+// Save executes the query and returns the updated TagSynonym entity.
+func (tsuo *TagSynonymUpdateOne) Save(ctx context.Context) (*TagSynonym, error) {
+	return withHooks(ctx, tsuo.sqlSave, tsuo.mutation, tsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsuo *TagSynonymUpdateOne) SaveX(ctx context.Context) *TagSynonym {
+	node, err := tsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tsuo *TagSynonymUpdateOne) Exec(ctx context.Context) error {
+	_, err := tsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsuo *TagSynonymUpdateOne) ExecX(ctx context.Context) {
+	if err := tsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tsuo *TagSynonymUpdateOne) check() error {
+	if v, ok := tsuo.mutation.Name(); ok {
+		if err := tagsynonym.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagSynonym.name": %w`, err)}
+		}
+	}
+	if v, ok := tsuo.mutation.Key(); ok {
+		if err := tagsynonym.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "TagSynonym.key": %w`, err)}
+		}
+	}
+	if tsuo.mutation.TagCleared() && len(tsuo.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagSynonym.tag"`)
+	}
+	return nil
+}
+
+func (tsuo *TagSynonymUpdateOne) sqlSave(ctx context.Context) (_node *TagSynonym, err error) {
+	if err := tsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagsynonym.Table, tagsynonym.Columns, sqlgraph.NewFieldSpec(tagsynonym.FieldID, field.TypeString))
+	id, ok := tsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagSynonym.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagsynonym.FieldID)
+		for _, f := range fields {
+			if !tagsynonym.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagsynonym.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tsuo.mutation.Name(); ok {
+		_spec.SetField(tagsynonym.FieldName, field.TypeString, value)
+	}
+	if value, ok := tsuo.mutation.Key(); ok {
+		_spec.SetField(tagsynonym.FieldKey, field.TypeString, value)
+	}
+	if tsuo.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tsuo.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagsynonym.TagTable,
+			Columns: []string{tagsynonym.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TagSynonym{config: tsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagsynonym.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tsuo.mutation.done = true
+	return _node, nil
+}
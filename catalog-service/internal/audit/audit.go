@@ -0,0 +1,122 @@
+// Package audit содержит данные истории изменений элементов, которые не зависят от ent:
+// кто выполнил изменение, снимок элемента и разницу между снимками.
+package audit
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+	"reflect"
+	"time"
+)
+
+// UserIDMetadataKey - ключ gRPC метаданных, в котором шлюз передаёт id пользователя
+const UserIDMetadataKey = "x-user-id"
+
+type actorContextKey struct{}
+
+// WithActor задаёт автора изменений для фоновых задач, у которых нет входящего запроса
+func WithActor(parent context.Context, actor string) context.Context {
+	return context.WithValue(parent, actorContextKey{}, actor)
+}
+
+// ActorFromContext возвращает автора изменения: заданного через WithActor
+// или пользователя из входящих gRPC метаданных. Пустая строка - автор неизвестен.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey{}).(string); ok {
+		return actor
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(UserIDMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+type revertedFromContextKey struct{}
+
+// WithRevertedFrom помечает изменения как откат к ревизии revisionID
+func WithRevertedFrom(parent context.Context, revisionID string) context.Context {
+	return context.WithValue(parent, revertedFromContextKey{}, revisionID)
+}
+
+// RevertedFromContext возвращает ревизию, к которой откатывается элемент, если это откат
+func RevertedFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(revertedFromContextKey{}).(string)
+	return id
+}
+
+// Snapshot - полное состояние элемента на момент ревизии
+type Snapshot struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Tags        []string   `json:"tags"`
+	ImageURL    string     `json:"image_url"`
+	Rating      float64    `json:"rating"`
+	ReviewCount int        `json:"review_count"`
+	Version     int64      `json:"version"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+// Change - старое и новое значение поля. Отсутствующее значение - null.
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// TrackedFields - поля, изменения которых попадают в историю. Рейтинг и счётчик отзывов
+// пересчитываются из отзывов и в истории не отражаются, иначе каждый отзыв создавал бы ревизию.
+var TrackedFields = []string{"title", "description", "tags", "image_url", "deleted_at"}
+
+func (s *Snapshot) value(field string) any {
+	if s == nil {
+		return nil
+	}
+	switch field {
+	case "title":
+		return s.Title
+	case "description":
+		return s.Description
+	case "tags":
+		return s.Tags
+	case "image_url":
+		return s.ImageURL
+	case "deleted_at":
+		return s.DeletedAt
+	}
+	return nil
+}
+
+// Diff возвращает изменённые отслеживаемые поля. old равен nil при создании, new - при окончательном удалении.
+func Diff(old, new *Snapshot) map[string]Change {
+	changes := make(map[string]Change)
+	for _, field := range TrackedFields {
+		o, n := normalize(old.value(field)), normalize(new.value(field))
+		if !reflect.DeepEqual(o, n) {
+			changes[field] = Change{Old: o, New: n}
+		}
+	}
+	return changes
+}
+
+// normalize приводит пустые значения к nil, чтобы пустая строка и пустой список
+// не отличались от отсутствующего поля
+func normalize(v any) any {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+	case []string:
+		if len(v) == 0 {
+			return nil
+		}
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return v.UTC()
+	}
+	return v
+}
@@ -0,0 +1,147 @@
+package dataio
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// utf8BOM добавляет Excel в начало CSV при сохранении в UTF-8
+const utf8BOM = "\ufeff"
+
+type csvDecoder struct {
+	reader *csv.Reader
+	header []string
+	lists  map[string]bool
+	sep    string
+	row    int
+}
+
+// newCSVDecoder читает заголовок: первая строка файла содержит имена колонок
+func newCSVDecoder(r io.Reader, opts Options) (*csvDecoder, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	d := &csvDecoder{
+		reader: reader,
+		lists:  make(map[string]bool, len(opts.Lists)),
+		sep:    opts.listSeparator(),
+	}
+	for _, name := range opts.Lists {
+		d.lists[name] = true
+	}
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return d, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, utf8BOM))
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate CSV column %q", name)
+		}
+		seen[name] = true
+		header[i] = name
+	}
+	d.header = header
+	return d, nil
+}
+
+func (d *csvDecoder) Decode() (*Record, error) {
+	if d.header == nil {
+		return nil, io.EOF
+	}
+
+	fields, err := d.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	d.row++
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &RecordError{Row: d.row, Err: parseErr.Err}
+		}
+		return nil, err
+	}
+	if len(fields) != len(d.header) {
+		return nil, &RecordError{Row: d.row, Err: fmt.Errorf("expected %d fields, got %d", len(d.header), len(fields))}
+	}
+
+	record := &Record{Row: d.row, Values: make(map[string][]string, len(fields))}
+	for i, value := range fields {
+		name := d.header[i]
+		if d.lists[name] {
+			record.Values[name] = splitList(value, d.sep)
+		} else {
+			record.Values[name] = []string{value}
+		}
+	}
+	return record, nil
+}
+
+func (d *csvDecoder) Close() error {
+	return nil
+}
+
+// splitList разбивает ячейку со списком; пустая ячейка - пустой список, а не список из пустой строки
+func splitList(value, sep string) []string {
+	if strings.TrimSpace(value) == "" {
+		return []string{}
+	}
+	return strings.Split(value, sep)
+}
+
+type csvEncoder struct {
+	writer  *csv.Writer
+	columns []Column
+	sep     string
+	fields  []string
+}
+
+func newCSVEncoder(w io.Writer, columns []Column, opts Options) (*csvEncoder, error) {
+	e := &csvEncoder{
+		writer:  csv.NewWriter(w),
+		columns: columns,
+		sep:     opts.listSeparator(),
+		fields:  make([]string, len(columns)),
+	}
+	for i, column := range columns {
+		e.fields[i] = column.Name
+	}
+	if err := e.writer.Write(e.fields); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *csvEncoder) Encode(values []any) error {
+	for i, value := range values {
+		switch v := value.(type) {
+		case string:
+			e.fields[i] = v
+		case []string:
+			e.fields[i] = strings.Join(v, e.sep)
+		case int64:
+			e.fields[i] = strconv.FormatInt(v, 10)
+		case float64:
+			e.fields[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			return fmt.Errorf("column %q: unsupported value type %T", e.columns[i].Name, value)
+		}
+	}
+	// csv.Writer буферизует строки сам, ошибка записи проявится при Flush
+	return e.writer.Write(e.fields)
+}
+
+func (e *csvEncoder) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
@@ -0,0 +1,131 @@
+package dataio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Format - формат файла импорта и экспорта
+type Format int
+
+const (
+	CSV Format = iota + 1
+	JSONL
+	Parquet
+)
+
+func (f Format) String() string {
+	switch f {
+	case CSV:
+		return "csv"
+	case JSONL:
+		return "jsonl"
+	case Parquet:
+		return "parquet"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// Kind - тип значений колонки при экспорте
+type Kind int
+
+const (
+	String Kind = iota
+	Strings
+	Int
+	Float
+)
+
+// Column - колонка файла при экспорте
+type Column struct {
+	Name string
+	Kind Kind
+}
+
+// DefaultListSeparator разделяет значения списка в одной ячейке CSV.
+// Запятая не подходит: в тегах она встречается чаще, чем вертикальная черта.
+const DefaultListSeparator = "|"
+
+type Options struct {
+	// ListSeparator разделяет значения списка в одной ячейке CSV, по умолчанию DefaultListSeparator
+	ListSeparator string
+	// Lists - колонки CSV со списками, которые при чтении разбиваются по ListSeparator
+	Lists []string
+}
+
+func (o Options) listSeparator() string {
+	if o.ListSeparator == "" {
+		return DefaultListSeparator
+	}
+	return o.ListSeparator
+}
+
+// Record - строка файла при импорте. Значения приведены к строкам: у скаляра одно значение,
+// у списка - по одному на элемент, у null - nil. Колонок, которых нет в строке, нет и в Values.
+type Record struct {
+	// Row - номер строки данных, начиная с 1
+	Row    int
+	Values map[string][]string
+}
+
+// RecordError - ошибка в одной строке файла. Decoder после неё может читать дальше.
+type RecordError struct {
+	Row int
+	Err error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// Decoder читает файл по одной строке, не загружая его в память целиком
+type Decoder interface {
+	// Decode возвращает следующую строку, *RecordError для некорректной строки
+	// или io.EOF, когда строки закончились. Любая другая ошибка означает, что файл дальше не читается.
+	Decode() (*Record, error)
+	Close() error
+}
+
+// Encoder пишет строки в файл по мере поступления
+type Encoder interface {
+	// Encode записывает строку. values идут в порядке колонок и имеют тип по Kind колонки:
+	// string, []string, int64 или float64.
+	Encode(values []any) error
+	// Close дописывает конец файла, но не закрывает writer
+	Close() error
+}
+
+// ErrUnknownFormat возвращается для формата, которого нет среди CSV, JSONL и Parquet
+var ErrUnknownFormat = errors.New("unknown data format")
+
+func NewDecoder(r io.Reader, format Format, opts Options) (Decoder, error) {
+	switch format {
+	case CSV:
+		return newCSVDecoder(r, opts)
+	case JSONL:
+		return newJSONLDecoder(r), nil
+	case Parquet:
+		return newParquetDecoder(r)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+func NewEncoder(w io.Writer, format Format, columns []Column, opts Options) (Encoder, error) {
+	switch format {
+	case CSV:
+		return newCSVEncoder(w, columns, opts)
+	case JSONL:
+		return newJSONLEncoder(w, columns), nil
+	case Parquet:
+		return newParquetEncoder(w, columns), nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
@@ -0,0 +1,163 @@
+package dataio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// maxJSONLineSize ограничивает одну строку JSONL, чтобы битый файл без переводов строк
+// не читался в память целиком
+const maxJSONLineSize = 1 << 20
+
+type jsonlDecoder struct {
+	scanner *bufio.Scanner
+	row     int
+}
+
+func newJSONLDecoder(r io.Reader) *jsonlDecoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxJSONLineSize)
+	return &jsonlDecoder{scanner: scanner}
+}
+
+// Decode пропускает пустые строки, они не считаются строками данных
+func (d *jsonlDecoder) Decode() (*Record, error) {
+	for d.scanner.Scan() {
+		line := bytes.TrimSpace(d.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		d.row++
+
+		values, err := decodeJSONObject(line)
+		if err != nil {
+			return nil, &RecordError{Row: d.row, Err: err}
+		}
+		return &Record{Row: d.row, Values: values}, nil
+	}
+
+	err := d.scanner.Err()
+	if errors.Is(err, bufio.ErrTooLong) {
+		return nil, fmt.Errorf("row %d: line is longer than %d bytes", d.row+1, maxJSONLineSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+func (d *jsonlDecoder) Close() error {
+	return nil
+}
+
+func decodeJSONObject(line []byte) (map[string][]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	// Числа оставляем в исходной записи: 1e3 не должно превратиться в "1000"
+	decoder.UseNumber()
+
+	var object map[string]any
+	if err := decoder.Decode(&object); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if object == nil {
+		return nil, errors.New("line must be a JSON object")
+	}
+	if decoder.More() {
+		return nil, errors.New("line must contain exactly one JSON object")
+	}
+
+	values := make(map[string][]string, len(object))
+	for name, value := range object {
+		switch v := value.(type) {
+		case nil:
+			values[name] = nil
+		case []any:
+			list := make([]string, 0, len(v))
+			for _, element := range v {
+				s, ok := jsonScalar(element)
+				if !ok {
+					return nil, fmt.Errorf("field %q: list elements must be strings, numbers or booleans", name)
+				}
+				list = append(list, s)
+			}
+			values[name] = list
+		default:
+			s, ok := jsonScalar(v)
+			if !ok {
+				return nil, fmt.Errorf("field %q: nested objects are not supported", name)
+			}
+			values[name] = []string{s}
+		}
+	}
+	return values, nil
+}
+
+func jsonScalar(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		if v {
+			return "true", true
+		}
+		return "false", true
+	default:
+		return "", false
+	}
+}
+
+type jsonlEncoder struct {
+	writer  io.Writer
+	columns []Column
+	keys    [][]byte
+	buf     bytes.Buffer
+}
+
+func newJSONLEncoder(w io.Writer, columns []Column) *jsonlEncoder {
+	e := &jsonlEncoder{
+		writer:  w,
+		columns: columns,
+		keys:    make([][]byte, len(columns)),
+	}
+	for i, column := range columns {
+		e.keys[i], _ = json.Marshal(column.Name)
+	}
+	return e
+}
+
+// Encode пишет поля в порядке колонок, а не в алфавитном, как json.Marshal для map
+func (e *jsonlEncoder) Encode(values []any) error {
+	e.buf.Reset()
+	e.buf.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			e.buf.WriteByte(',')
+		}
+		e.buf.Write(e.keys[i])
+		e.buf.WriteByte(':')
+
+		// Пустой список тегов пишется как [], а не null
+		if list, ok := value.([]string); ok && list == nil {
+			value = []string{}
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("column %q: %w", e.columns[i].Name, err)
+		}
+		e.buf.Write(encoded)
+	}
+	e.buf.WriteString("}\n")
+
+	_, err := e.writer.Write(e.buf.Bytes())
+	return err
+}
+
+func (e *jsonlEncoder) Close() error {
+	return nil
+}
@@ -0,0 +1,189 @@
+package dataio
+
+import (
+	"errors"
+	"fmt"
+	"github.com/parquet-go/parquet-go"
+	"io"
+	"os"
+	"strconv"
+)
+
+// parquetRowGroupSize ограничивает число строк, которые writer держит в памяти до записи группы
+const parquetRowGroupSize = 10000
+
+// parquetDecoder читает Parquet из временного файла: метаданные лежат в конце файла,
+// поэтому потоково его не прочитать, а держать весь файл в памяти не хочется
+type parquetDecoder struct {
+	file    *os.File
+	reader  *parquet.Reader
+	columns []string
+	rows    []parquet.Row
+	pending []parquet.Row
+	row     int
+}
+
+func newParquetDecoder(r io.Reader) (_ *parquetDecoder, err error) {
+	file, err := os.CreateTemp("", "catalog-import-*.parquet")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			file.Close()
+			os.Remove(file.Name())
+		}
+	}()
+
+	size, err := io.Copy(file, r)
+	if err != nil {
+		return nil, err
+	}
+	pf, err := parquet.OpenFile(file, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Parquet file: %w", err)
+	}
+
+	// Значения вложенных колонок (например, элементы списка tags.list.element)
+	// относятся к колонке верхнего уровня
+	paths := pf.Schema().Columns()
+	columns := make([]string, len(paths))
+	for i, path := range paths {
+		columns[i] = path[0]
+	}
+
+	return &parquetDecoder{
+		file:    file,
+		reader:  parquet.NewReader(pf),
+		columns: columns,
+		rows:    make([]parquet.Row, 64),
+	}, nil
+}
+
+func (d *parquetDecoder) Decode() (*Record, error) {
+	if len(d.pending) == 0 {
+		n, err := d.reader.ReadRows(d.rows)
+		if n == 0 {
+			if err == nil || errors.Is(err, io.EOF) {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+		d.pending = d.rows[:n]
+	}
+
+	row := d.pending[0]
+	d.pending = d.pending[1:]
+	d.row++
+
+	record := &Record{Row: d.row, Values: make(map[string][]string, len(d.columns))}
+	for _, name := range d.columns {
+		record.Values[name] = nil
+	}
+	for _, value := range row {
+		if value.IsNull() {
+			continue
+		}
+		name := d.columns[value.Column()]
+		record.Values[name] = append(record.Values[name], parquetString(value))
+	}
+	return record, nil
+}
+
+func (d *parquetDecoder) Close() error {
+	d.reader.Close()
+	err := d.file.Close()
+	if rerr := os.Remove(d.file.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+func parquetString(value parquet.Value) string {
+	switch value.Kind() {
+	case parquet.Boolean:
+		return strconv.FormatBool(value.Boolean())
+	case parquet.Int32:
+		return strconv.FormatInt(int64(value.Int32()), 10)
+	case parquet.Int64:
+		return strconv.FormatInt(value.Int64(), 10)
+	case parquet.Float:
+		return strconv.FormatFloat(float64(value.Float()), 'f', -1, 32)
+	case parquet.Double:
+		return strconv.FormatFloat(value.Double(), 'f', -1, 64)
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		return string(value.ByteArray())
+	default:
+		return value.String()
+	}
+}
+
+type parquetEncoder struct {
+	writer  *parquet.Writer
+	builder *parquet.RowBuilder
+	columns []Column
+	// indexes - номер листовой колонки схемы для каждой колонки файла.
+	// Колонки Parquet всегда упорядочены по имени, порядок из запроса не сохраняется.
+	indexes []int
+}
+
+func newParquetEncoder(w io.Writer, columns []Column) *parquetEncoder {
+	group := make(parquet.Group, len(columns))
+	for _, column := range columns {
+		switch column.Kind {
+		case Strings:
+			group[column.Name] = parquet.List(parquet.String())
+		case Int:
+			group[column.Name] = parquet.Optional(parquet.Int(64))
+		case Float:
+			group[column.Name] = parquet.Optional(parquet.Leaf(parquet.DoubleType))
+		default:
+			group[column.Name] = parquet.Optional(parquet.String())
+		}
+	}
+	schema := parquet.NewSchema("item", group)
+
+	indexes := make([]int, len(columns))
+	for i, column := range columns {
+		path := []string{column.Name}
+		if column.Kind == Strings {
+			path = append(path, "list", "element")
+		}
+		leaf, _ := schema.Lookup(path...)
+		indexes[i] = leaf.ColumnIndex
+	}
+
+	return &parquetEncoder{
+		writer:  parquet.NewWriter(w, schema, parquet.Compression(&parquet.Snappy), parquet.MaxRowsPerRowGroup(parquetRowGroupSize)),
+		builder: parquet.NewRowBuilder(schema),
+		columns: columns,
+		indexes: indexes,
+	}
+}
+
+func (e *parquetEncoder) Encode(values []any) error {
+	e.builder.Reset()
+	for i, value := range values {
+		index := e.indexes[i]
+		switch v := value.(type) {
+		case string:
+			e.builder.Add(index, parquet.ByteArrayValue([]byte(v)))
+		case []string:
+			for _, element := range v {
+				e.builder.Add(index, parquet.ByteArrayValue([]byte(element)))
+			}
+		case int64:
+			e.builder.Add(index, parquet.Int64Value(v))
+		case float64:
+			e.builder.Add(index, parquet.DoubleValue(v))
+		default:
+			return fmt.Errorf("column %q: unsupported value type %T", e.columns[i].Name, value)
+		}
+	}
+	_, err := e.writer.WriteRows([]parquet.Row{e.builder.Row()})
+	return err
+}
+
+func (e *parquetEncoder) Close() error {
+	return e.writer.Close()
+}
@@ -0,0 +1,72 @@
+package interceptor
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+// Проверки здоровья приходят каждые несколько секунд и только засоряют лог
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+// serverError - коды, которые означают проблему на стороне сервиса, а не ошибку клиента
+func serverError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded, codes.Unimplemented:
+		return true
+	}
+	return false
+}
+
+func logRPC(ctx context.Context, base *zap.Logger, start time.Time, err error) {
+	code := status.Code(err)
+	fields := []zap.Field{
+		zap.String("grpc_code", code.String()),
+		zap.Duration("duration", time.Since(start)),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, zap.String("peer", p.Addr.String()))
+	}
+
+	logger := logging.FromContext(ctx, base)
+	if serverError(code) {
+		logger.Error("RPC failed", append(fields, zap.Error(err))...)
+		return
+	}
+	logger.Info("RPC processed", fields...)
+}
+
+// UnaryAccessLog пишет в лог каждый unary вызов с кодом ответа и длительностью.
+// Должен стоять после logging.UnaryServerInterceptor, чтобы в записи был request_id.
+func UnaryAccessLog(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logRPC(ctx, base, start, err)
+		return resp, err
+	}
+}
+
+// StreamAccessLog пишет в лог каждый потоковый вызов после его завершения
+func StreamAccessLog(base *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		err := handler(srv, ss)
+		logRPC(ss.Context(), base, start, err)
+		return err
+	}
+}
@@ -0,0 +1,81 @@
+package interceptor
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// UnaryDeadline ограничивает время обработки unary вызова.
+// Вызов без дедлайна получает defaultTimeout, а слишком далёкий дедлайн клиента сокращается до maxTimeout,
+// чтобы зависший запрос к базе не держал соединение бесконечно.
+// Вызов с уже истёкшим дедлайном отклоняется, не доходя до обработчика.
+func UnaryDeadline(defaultTimeout, maxTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		timeout, err := callTimeout(ctx, defaultTimeout, maxTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		resp, err := handler(ctx, req)
+		// Обработчики возвращают codes.Internal при ошибке базы; если причина - истёкший дедлайн,
+		// клиенту важнее знать именно это
+		if code := status.Code(err); (code == codes.Internal || code == codes.Unknown) && ctx.Err() == context.DeadlineExceeded {
+			return nil, status.Error(codes.DeadlineExceeded, "deadline exceeded")
+		}
+		return resp, err
+	}
+}
+
+// StreamDeadline ограничивает время потокового вызова так же, как UnaryDeadline.
+// Методы из exempt не ограничиваются: подписки вроде WatchItems живут, пока клиент не отключится,
+// а зависание в них не держит транзакцию, так как события читаются короткими запросами.
+func StreamDeadline(defaultTimeout, maxTimeout time.Duration, exempt ...string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		for _, method := range exempt {
+			if info.FullMethod == method {
+				return handler(srv, ss)
+			}
+		}
+
+		timeout, err := callTimeout(ss.Context(), defaultTimeout, maxTimeout)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(ss.Context(), timeout)
+		defer cancel()
+
+		err = handler(srv, &deadlineStream{ServerStream: ss, ctx: ctx})
+		if code := status.Code(err); (code == codes.Internal || code == codes.Unknown) && ctx.Err() == context.DeadlineExceeded {
+			return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+		}
+		return err
+	}
+}
+
+type deadlineStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *deadlineStream) Context() context.Context {
+	return s.ctx
+}
+
+// callTimeout выбирает время на вызов: defaultTimeout без дедлайна клиента, но не больше maxTimeout
+func callTimeout(ctx context.Context, defaultTimeout, maxTimeout time.Duration) (time.Duration, error) {
+	timeout := defaultTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+		if timeout <= 0 {
+			return 0, status.Error(codes.DeadlineExceeded, "deadline exceeded before the call was handled")
+		}
+	}
+	return min(timeout, maxTimeout), nil
+}
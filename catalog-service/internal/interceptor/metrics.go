@@ -0,0 +1,97 @@
+package interceptor
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+var (
+	rpcStartedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_started_total",
+			Help: "Total number of RPCs started on the server",
+		},
+		[]string{"grpc_service", "grpc_method", "grpc_type"},
+	)
+
+	rpcHandledTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of RPCs completed on the server, regardless of success or failure",
+		},
+		[]string{"grpc_service", "grpc_method", "grpc_type", "grpc_code"},
+	)
+
+	rpcHandlingSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Duration of RPCs handled by the server",
+			Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"grpc_service", "grpc_method", "grpc_type", "grpc_code"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(rpcStartedTotal)
+	prometheus.MustRegister(rpcHandledTotal)
+	prometheus.MustRegister(rpcHandlingSeconds)
+}
+
+// splitMethod разбирает "/package.Service/Method" на сервис и метод
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+func observe(fullMethod, rpcType string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	code := status.Code(err).String()
+	rpcHandledTotal.WithLabelValues(service, method, rpcType, code).Inc()
+	rpcHandlingSeconds.WithLabelValues(service, method, rpcType, code).Observe(time.Since(start).Seconds())
+}
+
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return "bidi_stream"
+	case info.IsClientStream:
+		return "client_stream"
+	default:
+		return "server_stream"
+	}
+}
+
+// UnaryMetrics считает число и длительность unary вызовов по методу и коду ответа
+func UnaryMetrics() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		service, method := splitMethod(info.FullMethod)
+		rpcStartedTotal.WithLabelValues(service, method, "unary").Inc()
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observe(info.FullMethod, "unary", start, err)
+		return resp, err
+	}
+}
+
+// StreamMetrics считает число и длительность потоковых вызовов по методу и коду ответа
+func StreamMetrics() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		rpcType := streamType(info)
+		service, method := splitMethod(info.FullMethod)
+		rpcStartedTotal.WithLabelValues(service, method, rpcType).Inc()
+
+		start := time.Now()
+		err := handler(srv, ss)
+		observe(info.FullMethod, rpcType, start, err)
+		return err
+	}
+}
@@ -0,0 +1,57 @@
+package interceptor
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"runtime/debug"
+)
+
+var panicsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "grpc_server_panics_recovered_total",
+		Help: "Total number of panics recovered in gRPC handlers",
+	},
+	[]string{"grpc_service", "grpc_method"},
+)
+
+func init() {
+	prometheus.MustRegister(panicsTotal)
+}
+
+// recoverPanic превращает панику обработчика в codes.Internal, чтобы она не останавливала процесс.
+// Клиенту детали паники не передаются, они остаются в логе вместе со стеком.
+func recoverPanic(ctx context.Context, base *zap.Logger, fullMethod string, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	service, method := splitMethod(fullMethod)
+	panicsTotal.WithLabelValues(service, method).Inc()
+	logging.FromContext(ctx, base).Error("Recovered from panic in gRPC handler",
+		zap.Any("panic", r),
+		zap.ByteString("stack", debug.Stack()),
+	)
+	*err = status.Error(codes.Internal, "internal error")
+}
+
+// UnaryRecovery перехватывает панику в unary обработчике
+func UnaryRecovery(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer recoverPanic(ctx, base, info.FullMethod, &err)
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery перехватывает панику в потоковом обработчике
+func StreamRecovery(base *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer recoverPanic(ss.Context(), base, info.FullMethod, &err)
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDMetadataKey - ключ gRPC метаданных, в котором шлюз передаёт X-Request-ID
+const RequestIDMetadataKey = "x-request-id"
+
+type (
+	loggerContextKey    struct{}
+	requestIDContextKey struct{}
+)
+
+// FromContext возвращает логгер запроса с полями request_id, grpc_method и trace_id.
+// Вне обработки запроса возвращается fallback.
+func FromContext(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(loggerContextKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	return fallback
+}
+
+// RequestIDFromContext возвращает id запроса: сохранённый интерцептором, в том числе сгенерированный,
+// а до интерцептора - из входящих gRPC метаданных
+func RequestIDFromContext(ctx context.Context) string {
+	if requestID, ok := ctx.Value(requestIDContextKey{}).(string); ok {
+		return requestID
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// withRequestLogger кладёт в контекст логгер и id запроса. Если вызывающая сторона
+// не передала id запроса, он генерируется, чтобы логи, ревизии и события одного вызова всё равно можно было связать.
+func withRequestLogger(ctx context.Context, base *zap.Logger, method string) context.Context {
+	requestID := RequestIDFromContext(ctx)
+	if requestID == "" {
+		requestID = newRequestID()
+	}
+
+	fields := []zap.Field{
+		zap.String("request_id", requestID),
+		zap.String("grpc_method", method),
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		fields = append(fields, zap.String("trace_id", sc.TraceID().String()))
+	}
+
+	// Возвращаем id клиенту, чтобы он мог сослаться на него при разборе ошибки
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, requestID))
+	ctx = context.WithValue(ctx, requestIDContextKey{}, requestID)
+	return context.WithValue(ctx, loggerContextKey{}, base.With(fields...))
+}
+
+// UnaryServerInterceptor добавляет в контекст каждого вызова логгер запроса
+func UnaryServerInterceptor(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withRequestLogger(ctx, base, info.FullMethod), req)
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor добавляет логгер запроса в контекст потоковых вызовов
+func StreamServerInterceptor(base *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRequestLogger(ss.Context(), base, info.FullMethod)
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
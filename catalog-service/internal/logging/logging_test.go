@@ -0,0 +1,87 @@
+package logging
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"regexp"
+	"testing"
+)
+
+var generatedRequestID = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+func TestUnaryServerInterceptorRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		// want - ожидаемый id; пустая строка - id должен быть сгенерирован
+		want string
+	}{
+		{"from metadata", metadata.Pairs(RequestIDMetadataKey, "req-1"), "req-1"},
+		{"no metadata", nil, ""},
+		{"no request id in metadata", metadata.Pairs("x-user-id", "user-1"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zap.InfoLevel)
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var requestID string
+			handler := func(ctx context.Context, _ any) (any, error) {
+				requestID = RequestIDFromContext(ctx)
+				FromContext(ctx, zap.NewNop()).Info("handled")
+				return nil, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/catalog.CatalogService/GetItem"}
+			if _, err := UnaryServerInterceptor(zap.New(core))(ctx, nil, info, handler); err != nil {
+				t.Fatalf("interceptor: %v", err)
+			}
+
+			if tt.want != "" && requestID != tt.want {
+				t.Errorf("request id = %q, want %q", requestID, tt.want)
+			}
+			if tt.want == "" && !generatedRequestID.MatchString(requestID) {
+				t.Errorf("request id = %q, want a generated id", requestID)
+			}
+			// Ревизии и события берут id из контекста, поэтому он должен совпадать с id в логах
+			entries := logs.All()
+			if len(entries) != 1 {
+				t.Fatalf("got %d log entries, want 1", len(entries))
+			}
+			if logged := entries[0].ContextMap()["request_id"]; logged != requestID {
+				t.Errorf("logged request id = %v, context request id = %q", logged, requestID)
+			}
+		})
+	}
+}
+
+func TestRequestIDFromContext(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"empty", context.Background(), ""},
+		{"metadata", metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "req-1")), "req-1"},
+		{
+			name: "context value wins over metadata",
+			ctx: context.WithValue(
+				metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadataKey, "req-1")),
+				requestIDContextKey{}, "req-2",
+			),
+			want: "req-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RequestIDFromContext(tt.ctx); got != tt.want {
+				t.Errorf("RequestIDFromContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
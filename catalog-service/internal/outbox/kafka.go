@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"context"
+	"github.com/segmentio/kafka-go"
+	"time"
+)
+
+// KafkaPublisher публикует события в один топик с ключом по id элемента,
+// чтобы события одного элемента читались по порядку. Kafka не отбрасывает повторы,
+// поэтому ID события передаётся в заголовке Event-ID для дедупликации на стороне подписчика.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaPublisher(brokers []string, topic string) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Topic:                  topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+			// Relay отправляет события по одному и ждёт подтверждения, долго копить пачку незачем
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, msg Message) error {
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(msg.AggregateID),
+		Value: msg.Payload,
+		Headers: []kafka.Header{
+			{Key: "Event-ID", Value: []byte(msg.ID)},
+			{Key: "Event-Type", Value: []byte(msg.Type)},
+			{Key: "Content-Type", Value: []byte("application/json")},
+		},
+		Time: msg.CreatedAt,
+	})
+}
+
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,53 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryPublisher хранит последние события в памяти процесса. Подходит для локального
+// запуска без брокера; повторная доставка события с тем же ID игнорируется.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	capacity int
+	messages []Message
+	seen     map[string]struct{}
+}
+
+// NewMemoryPublisher создаёт publisher, который помнит не больше capacity последних событий
+func NewMemoryPublisher(capacity int) *MemoryPublisher {
+	return &MemoryPublisher{
+		capacity: capacity,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.seen[msg.ID]; ok {
+		return nil
+	}
+	if len(p.messages) >= p.capacity {
+		delete(p.seen, p.messages[0].ID)
+		p.messages = p.messages[1:]
+	}
+	p.seen[msg.ID] = struct{}{}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+// Messages возвращает сохранённые события в порядке публикации
+func (p *MemoryPublisher) Messages() []Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	messages := make([]Message, len(p.messages))
+	copy(messages, p.messages)
+	return messages
+}
+
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"time"
+)
+
+// natsDuplicateWindow - за это время JetStream отбрасывает повторы с тем же Nats-Msg-Id
+const natsDuplicateWindow = 10 * time.Minute
+
+// NATSPublisher публикует события в JetStream в subject <prefix>.<aggregate>.<type>,
+// например catalog.item.ItemCreated. ID события передаётся как Nats-Msg-Id,
+// поэтому повторы внутри окна дедупликации отбрасывает сам сервер.
+type NATSPublisher struct {
+	conn   *nats.Conn
+	js     jetstream.JetStream
+	prefix string
+}
+
+// NewNATSPublisher подключается к NATS и создаёт stream для subject prefix.>, если его нет
+func NewNATSPublisher(ctx context.Context, url, stream, prefix string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url, nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to nats: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("creating jetstream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       stream,
+		Subjects:   []string{prefix + ".>"},
+		Duplicates: natsDuplicateWindow,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("creating stream %s: %w", stream, err)
+	}
+
+	return &NATSPublisher{
+		conn:   conn,
+		js:     js,
+		prefix: prefix,
+	}, nil
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, msg Message) error {
+	m := nats.NewMsg(fmt.Sprintf("%s.%s.%s", p.prefix, msg.AggregateType, msg.Type))
+	m.Data = msg.Payload
+	m.Header.Set("Content-Type", "application/json")
+	m.Header.Set("Event-Type", msg.Type)
+
+	_, err := p.js.PublishMsg(ctx, m, jetstream.WithMsgID(msg.ID))
+	return err
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
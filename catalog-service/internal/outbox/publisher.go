@@ -0,0 +1,25 @@
+package outbox
+
+import (
+	"context"
+	"time"
+)
+
+// Message - событие из outbox в виде, не зависящем от брокера
+type Message struct {
+	// ID - ключ дедупликации, одинаковый при повторных попытках доставки
+	ID            string
+	Type          string
+	AggregateType string
+	// AggregateID определяет порядок: события одного элемента попадают в одну партицию
+	AggregateID string
+	Payload     []byte
+	CreatedAt   time.Time
+}
+
+// Publisher доставляет события в брокер. Publish должен вернуть ошибку,
+// если брокер не подтвердил приём, тогда relay повторит доставку позже.
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
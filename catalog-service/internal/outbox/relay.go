@@ -0,0 +1,229 @@
+package outbox
+
+import (
+	"context"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/outboxevent"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"time"
+)
+
+var (
+	publishedEventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "catalog_outbox_published_total",
+			Help: "Total number of outbox events delivered to the broker",
+		},
+		[]string{"event_type"},
+	)
+
+	publishErrorsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "catalog_outbox_publish_errors_total",
+			Help: "Total number of failed outbox event deliveries",
+		},
+	)
+
+	pendingEvents = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "catalog_outbox_pending_events",
+			Help: "Number of outbox events waiting for delivery",
+		},
+	)
+)
+
+// relayLockKey - ключ advisory lock, который держит реплика, публикующая события
+const relayLockKey = 0x6f7574626f78
+
+func init() {
+	prometheus.MustRegister(publishedEventsTotal)
+	prometheus.MustRegister(publishErrorsTotal)
+	prometheus.MustRegister(pendingEvents)
+}
+
+// Relay читает неотправленные события из outbox и публикует их через Publisher.
+// Событие помечается отправленным только после подтверждения брокера, поэтому при сбое
+// между публикацией и коммитом оно будет отправлено повторно: доставка не реже одного раза.
+type Relay struct {
+	client    *ent.Client
+	publisher Publisher
+	interval  time.Duration
+	batchSize int
+	retention time.Duration
+	logger    *zap.Logger
+}
+
+func NewRelay(client *ent.Client, publisher Publisher, interval time.Duration, batchSize int, retention time.Duration, logger *zap.Logger) *Relay {
+	return &Relay{
+		client:    client,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: batchSize,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Run отправляет накопившиеся события сразу и затем каждые interval, пока не будет отменён ctx
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Drain(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Error("Failed to relay outbox events", zap.Error(err))
+		}
+		if err := r.Cleanup(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Error("Failed to clean up outbox events", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain отправляет события пачками, пока очередь не опустеет или брокер не вернёт ошибку
+func (r *Relay) Drain(ctx context.Context) error {
+	for {
+		sent, err := r.relayBatch(ctx)
+		if err != nil {
+			return err
+		}
+		if sent < r.batchSize {
+			break
+		}
+	}
+
+	pending, err := r.client.OutboxEvent.Query().
+		Where(outboxevent.PublishedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	pendingEvents.Set(float64(pending))
+	return nil
+}
+
+// relayBatch публикует одну пачку событий в порядке создания.
+// Если бы реплики брали пачки параллельно, события одного элемента из разных пачек
+// могли бы прийти в брокер в обратном порядке, поэтому публикует только реплика,
+// взявшая advisory lock; остальные пропускают итерацию.
+// После первой ошибки пачка прерывается, чтобы не нарушить порядок событий.
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	locked, err := tryRelayLock(ctx, tx)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	if !locked {
+		return 0, tx.Rollback()
+	}
+
+	events, err := tx.OutboxEvent.Query().
+		Where(outboxevent.PublishedAtIsNil()).
+		Order(ent.Asc(outboxevent.FieldCreatedAt), ent.Asc(outboxevent.FieldID)).
+		Limit(r.batchSize).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+
+	var (
+		published  []string
+		publishErr error
+	)
+	for _, e := range events {
+		publishErr = r.publisher.Publish(ctx, Message{
+			ID:            e.ID,
+			Type:          e.EventType,
+			AggregateType: e.AggregateType,
+			AggregateID:   e.AggregateID,
+			Payload:       e.Payload,
+			CreatedAt:     e.CreatedAt,
+		})
+		if publishErr != nil {
+			publishErrorsTotal.Inc()
+			err := tx.OutboxEvent.UpdateOneID(e.ID).
+				AddAttempts(1).
+				SetLastError(publishErr.Error()).
+				Exec(ctx)
+			if err != nil {
+				return 0, rollback(tx, err)
+			}
+			break
+		}
+		published = append(published, e.ID)
+		publishedEventsTotal.WithLabelValues(e.EventType).Inc()
+	}
+
+	if len(published) > 0 {
+		err := tx.OutboxEvent.Update().
+			Where(outboxevent.IDIn(published...)).
+			SetPublishedAt(time.Now()).
+			AddAttempts(1).
+			Exec(ctx)
+		if err != nil {
+			return 0, rollback(tx, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	if publishErr != nil {
+		return len(published), fmt.Errorf("publishing event: %w", publishErr)
+	}
+	return len(published), nil
+}
+
+// Cleanup удаляет отправленные события старше retention
+func (r *Relay) Cleanup(ctx context.Context) error {
+	deleted, err := r.client.OutboxEvent.Delete().
+		Where(
+			outboxevent.PublishedAtNotNil(),
+			outboxevent.PublishedAtLT(time.Now().Add(-r.retention)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		r.logger.Info("Cleaned up published outbox events", zap.Int("events", deleted))
+	}
+	return nil
+}
+
+// tryRelayLock берёт advisory lock до конца транзакции tx, не дожидаясь его освобождения
+func tryRelayLock(ctx context.Context, tx *ent.Tx) (bool, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", relayLockKey)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var locked bool
+	if rows.Next() {
+		if err := rows.Scan(&locked); err != nil {
+			return false, err
+		}
+	}
+	return locked, rows.Err()
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
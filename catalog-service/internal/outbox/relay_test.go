@@ -0,0 +1,143 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/outboxevent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/testdb"
+	"go.uber.org/zap"
+	"math/rand/v2"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingPublisher запоминает порядок публикации и может отказать в приёме события
+type recordingPublisher struct {
+	mu  sync.Mutex
+	ids []string
+	// fail - сколько раз ещё отказать событию с этим id
+	fail map[string]int
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.fail[msg.ID] > 0 {
+		p.fail[msg.ID]--
+		return errors.New("broker unavailable")
+	}
+	p.ids = append(p.ids, msg.ID)
+	return nil
+}
+
+func (p *recordingPublisher) Close() error {
+	return nil
+}
+
+func (p *recordingPublisher) published() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.ids...)
+}
+
+// createEvents добавляет n событий одного элемента и возвращает их id в порядке создания.
+// Строки вставляются вперемешку, чтобы порядок публикации задавал created_at, а не порядок вставки.
+func createEvents(t *testing.T, client *ent.Client, n int) []string {
+	t.Helper()
+	start := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("event-%03d", i)
+	}
+	for _, i := range rand.Perm(n) {
+		client.OutboxEvent.Create().
+			SetID(ids[i]).
+			SetAggregateType("item").
+			SetAggregateID("item-1").
+			SetEventType("item.updated").
+			SetPayload([]byte(`{}`)).
+			SetCreatedAt(start.Add(time.Duration(i) * time.Millisecond)).
+			SaveX(context.Background())
+	}
+	return ids
+}
+
+// TestRelayConcurrentReplicas проверяет, что две реплики вместе публикуют каждое событие
+// ровно один раз и в порядке создания: пачки берёт только реплика с advisory lock
+func TestRelayConcurrentReplicas(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	want := createEvents(t, client, 100)
+
+	publisher := &recordingPublisher{}
+	relays := []*Relay{
+		NewRelay(client, publisher, time.Second, 7, time.Hour, zap.NewNop()),
+		NewRelay(client, publisher, time.Second, 7, time.Hour, zap.NewNop()),
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for client.OutboxEvent.Query().Where(outboxevent.PublishedAtIsNil()).ExistX(ctx) {
+		if time.Now().After(deadline) {
+			t.Fatal("events were not published in time")
+		}
+		var wg sync.WaitGroup
+		for _, r := range relays {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := r.Drain(ctx); err != nil {
+					t.Errorf("Drain: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	if got := publisher.published(); !reflect.DeepEqual(got, want) {
+		t.Errorf("published %d events %v, want %d events in creation order", len(got), got, len(want))
+	}
+	for _, e := range client.OutboxEvent.Query().AllX(ctx) {
+		if e.Attempts != 1 {
+			t.Errorf("event %s has %d attempts, want 1", e.ID, e.Attempts)
+		}
+	}
+}
+
+func TestRelayStopsOnPublishError(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	ids := createEvents(t, client, 4)
+
+	publisher := &recordingPublisher{fail: map[string]int{ids[1]: 1}}
+	relay := NewRelay(client, publisher, time.Second, 10, time.Hour, zap.NewNop())
+
+	// Первая попытка публикует события до отказа и не трогает следующие за ним
+	if err := relay.Drain(ctx); err == nil {
+		t.Fatal("Drain succeeded, want publish error")
+	}
+	if got := publisher.published(); !reflect.DeepEqual(got, ids[:1]) {
+		t.Errorf("published %v, want %v", got, ids[:1])
+	}
+	failed := client.OutboxEvent.GetX(ctx, ids[1])
+	if failed.PublishedAt != nil || failed.Attempts != 1 || failed.LastError != "broker unavailable" {
+		t.Errorf("failed event: published_at = %v, attempts = %d, last_error = %q", failed.PublishedAt, failed.Attempts, failed.LastError)
+	}
+	if pending := client.OutboxEvent.Query().Where(outboxevent.PublishedAtIsNil()).CountX(ctx); pending != 3 {
+		t.Errorf("pending events = %d, want 3", pending)
+	}
+
+	// Повтор продолжает с отказавшего события, сохраняя порядок
+	if err := relay.Drain(ctx); err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	if got := publisher.published(); !reflect.DeepEqual(got, ids) {
+		t.Errorf("published %v, want %v", got, ids)
+	}
+	if retried := client.OutboxEvent.GetX(ctx, ids[1]); retried.PublishedAt == nil || retried.Attempts != 2 {
+		t.Errorf("retried event: published_at = %v, attempts = %d, want published after 2 attempts", retried.PublishedAt, retried.Attempts)
+	}
+}
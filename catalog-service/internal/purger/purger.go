@@ -0,0 +1,102 @@
+package purger
+
+import (
+	"context"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/schema"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/audit"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"time"
+)
+
+// Actor - автор очистки корзины в истории изменений
+const Actor = "system:purger"
+
+var (
+	purgedItemsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "catalog_purged_items_total",
+			Help: "Total number of soft-deleted items removed permanently",
+		},
+	)
+
+	purgeErrorsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "catalog_purge_errors_total",
+			Help: "Total number of failed purge runs",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(purgedItemsTotal)
+	prometheus.MustRegister(purgeErrorsTotal)
+}
+
+// Purger окончательно удаляет элементы, которые пролежали в корзине дольше retention.
+// Отзывы удаляются вместе с элементами каскадом в базе.
+type Purger struct {
+	client    *ent.Client
+	retention time.Duration
+	interval  time.Duration
+	logger    *zap.Logger
+}
+
+func New(client *ent.Client, retention, interval time.Duration, logger *zap.Logger) *Purger {
+	return &Purger{
+		client:    client,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// Run очищает корзину сразу и затем каждые interval, пока не будет отменён ctx
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Purge(ctx); err != nil && ctx.Err() == nil {
+			purgeErrorsTotal.Inc()
+			p.logger.Error("Failed to purge deleted items", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge удаляет элементы вместе с записью об удалении в истории в одной транзакции
+func (p *Purger) Purge(ctx context.Context) error {
+	ctx = audit.WithActor(schema.SkipSoftDelete(ctx), Actor)
+
+	tx, err := p.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	purged, err := tx.Item.Delete().
+		Where(item.DeletedAtLT(time.Now().Add(-p.retention))).
+		Exec(ctx)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	purgedItemsTotal.Add(float64(purged))
+	if purged > 0 {
+		p.logger.Info("Purged deleted items", zap.Int("items", purged), zap.Duration("retention", p.retention))
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/apikey"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/audit"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/logging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// apiKeyPrefix помогает опознать ключ в логах и сканерах секретов
+	apiKeyPrefix = "gpk_"
+	// lastUsedResolution - как часто обновляется last_used_at, чтобы не писать в базу на каждый запрос
+	lastUsedResolution = time.Minute
+	// maxAPIKeyTTL ограничивает срок жизни ключа; без ограничения ttl_seconds переполняет time.Duration
+	maxAPIKeyTTL = 365 * 24 * time.Hour
+	// apiKeyAdminRole нужна для выпуска, просмотра и отзыва ключей; ею же шлюз закрывает /api/v1/admin
+	apiKeyAdminRole = "admin"
+	// userRolesMetadataKey - ключ gRPC метаданных, в котором шлюз передаёт роли пользователя через запятую
+	userRolesMetadataKey = "x-user-roles"
+)
+
+type APIKeyService struct {
+	proto.UnimplementedAPIKeyServiceServer
+	client *ent.Client
+	logger *zap.Logger
+}
+
+func NewAPIKeyService(client *ent.Client, logger *zap.Logger) *APIKeyService {
+	return &APIKeyService{
+		client: client,
+		logger: logger,
+	}
+}
+
+// log возвращает логгер текущего запроса с request_id, а вне запроса - логгер сервиса
+func (s *APIKeyService) log(ctx context.Context) *zap.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// requireAPIKeyAdmin проверяет роли, переданные шлюзом. Каталог доверяет метаданным шлюза так же,
+// как автору изменений в истории, поэтому gRPC порт каталога не должен быть доступен снаружи.
+// ValidateAPIKey не проверяется: шлюз вызывает его до того, как личность клиента известна.
+func requireAPIKeyAdmin(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, roles := range md.Get(userRolesMetadataKey) {
+		if slices.Contains(strings.Split(roles, ","), apiKeyAdminRole) {
+			return nil
+		}
+	}
+	if audit.ActorFromContext(ctx) == "" {
+		return status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return status.Error(codes.PermissionDenied, "missing required role "+apiKeyAdminRole)
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *APIKeyService) IssueAPIKey(ctx context.Context, req *proto.IssueAPIKeyRequest) (*proto.IssueAPIKeyResponse, error) {
+	if err := requireAPIKeyAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.TtlSeconds < 0 || req.TtlSeconds > int64(maxAPIKeyTTL/time.Second) {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_seconds must be between 0 and %d", int64(maxAPIKeyTTL/time.Second))
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		s.log(ctx).Error("Failed to generate api key", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to issue api key")
+	}
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	builder := s.client.APIKey.Create().
+		SetName(req.Name).
+		SetPrefix(key[:len(apiKeyPrefix)+8]).
+		SetKeyHash(hashAPIKey(key)).
+		SetScopes(normalizeTags(req.Scopes)).
+		SetCreatedBy(req.CreatedBy)
+	if req.TtlSeconds > 0 {
+		builder.SetExpiresAt(time.Now().Add(time.Duration(req.TtlSeconds) * time.Second))
+	}
+
+	k, err := builder.Save(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to issue api key", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to issue api key")
+	}
+
+	return &proto.IssueAPIKeyResponse{
+		ApiKey: toProtoAPIKey(k),
+		Key:    key,
+	}, nil
+}
+
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, req *proto.ListAPIKeysRequest) (*proto.ListAPIKeysResponse, error) {
+	if err := requireAPIKeyAdmin(ctx); err != nil {
+		return nil, err
+	}
+	query := s.client.APIKey.Query()
+	if !req.IncludeRevoked {
+		query = query.Where(apikey.RevokedAtIsNil())
+	}
+
+	keys, err := query.Order(ent.Desc(apikey.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to list api keys", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list api keys")
+	}
+
+	protoKeys := make([]*proto.APIKey, len(keys))
+	for i, k := range keys {
+		protoKeys[i] = toProtoAPIKey(k)
+	}
+	return &proto.ListAPIKeysResponse{
+		ApiKeys: protoKeys,
+	}, nil
+}
+
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, req *proto.RevokeAPIKeyRequest) (*proto.APIKey, error) {
+	if err := requireAPIKeyAdmin(ctx); err != nil {
+		return nil, err
+	}
+	k, err := s.client.APIKey.Get(ctx, req.Id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "api key not found")
+		}
+		s.log(ctx).Error("Failed to get api key", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to revoke api key")
+	}
+	// Повторный отзыв не меняет время первого отзыва
+	if k.RevokedAt != nil {
+		return toProtoAPIKey(k), nil
+	}
+
+	k, err = k.Update().SetRevokedAt(time.Now()).Save(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to revoke api key", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to revoke api key")
+	}
+	return toProtoAPIKey(k), nil
+}
+
+func (s *APIKeyService) ValidateAPIKey(ctx context.Context, req *proto.ValidateAPIKeyRequest) (*proto.APIKey, error) {
+	if !strings.HasPrefix(req.Key, apiKeyPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+
+	k, err := s.client.APIKey.Query().
+		Where(apikey.KeyHash(hashAPIKey(req.Key))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+		s.log(ctx).Error("Failed to get api key", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to validate api key")
+	}
+
+	now := time.Now()
+	if k.RevokedAt != nil {
+		return nil, status.Error(codes.Unauthenticated, "api key is revoked")
+	}
+	if k.ExpiresAt != nil && !now.Before(*k.ExpiresAt) {
+		return nil, status.Error(codes.Unauthenticated, "api key is expired")
+	}
+
+	// Условие в WHERE не даёт параллельным запросам перезаписывать last_used_at чаще, чем раз в lastUsedResolution
+	if k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= lastUsedResolution {
+		err := s.client.APIKey.Update().
+			Where(
+				apikey.ID(k.ID),
+				apikey.Or(apikey.LastUsedAtIsNil(), apikey.LastUsedAtLT(now.Add(-lastUsedResolution))),
+			).
+			SetLastUsedAt(now).
+			Exec(ctx)
+		if err != nil {
+			// Ключ действителен, поэтому ошибка обновления статистики не должна отклонять запрос
+			s.log(ctx).Warn("Failed to update api key last used time", zap.Error(err))
+		} else {
+			k.LastUsedAt = &now
+		}
+	}
+
+	return toProtoAPIKey(k), nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func toProtoAPIKey(k *ent.APIKey) *proto.APIKey {
+	return &proto.APIKey{
+		Id:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		CreatedBy:  k.CreatedBy,
+		ExpiresAt:  formatOptionalTime(k.ExpiresAt),
+		LastUsedAt: formatOptionalTime(k.LastUsedAt),
+		RevokedAt:  formatOptionalTime(k.RevokedAt),
+		CreatedAt:  k.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/audit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+	"time"
+)
+
+// asUser возвращает контекст входящего вызова от шлюза с личностью пользователя
+func asUser(id, roles string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		audit.UserIDMetadataKey, id,
+		userRolesMetadataKey, roles,
+	))
+}
+
+func TestHashAPIKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"gpk_test", "72aa4bb55bdd2a28ac83ae50b476d0fd7a514c02a7a40356445a2e703f86cbd3"},
+		{"", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	}
+	for _, tt := range tests {
+		if got := hashAPIKey(tt.key); got != tt.want {
+			t.Errorf("hashAPIKey(%q) = %s, want %s", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestAPIKeyAuthorization(t *testing.T) {
+	_, client := newTestService(t)
+	s := NewAPIKeyService(client, zap.NewNop())
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"no identity", context.Background(), codes.Unauthenticated},
+		{"user without roles", asUser("user-1", ""), codes.PermissionDenied},
+		{"user without admin role", asUser("user-1", "editor,catalog:write"), codes.PermissionDenied},
+		{"role is matched exactly", asUser("user-1", "administrator"), codes.PermissionDenied},
+		{"admin", asUser("user-1", "editor,admin"), codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issued, err := s.IssueAPIKey(tt.ctx, &proto.IssueAPIKeyRequest{Name: "ci"})
+			if status.Code(err) != tt.want {
+				t.Errorf("IssueAPIKey error = %v, want %v", err, tt.want)
+			}
+			if _, err := s.ListAPIKeys(tt.ctx, &proto.ListAPIKeysRequest{}); status.Code(err) != tt.want {
+				t.Errorf("ListAPIKeys error = %v, want %v", err, tt.want)
+			}
+			id := "missing"
+			if issued != nil {
+				id = issued.ApiKey.Id
+			}
+			if _, err := s.RevokeAPIKey(tt.ctx, &proto.RevokeAPIKeyRequest{Id: id}); status.Code(err) != tt.want {
+				t.Errorf("RevokeAPIKey error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAPIKey(t *testing.T) {
+	_, client := newTestService(t)
+	s := NewAPIKeyService(client, zap.NewNop())
+	admin := asUser("user-1", "admin")
+	ctx := context.Background()
+
+	issue := func(t *testing.T) *proto.IssueAPIKeyResponse {
+		t.Helper()
+		resp, err := s.IssueAPIKey(admin, &proto.IssueAPIKeyRequest{Name: "ci", Scopes: []string{"catalog:write"}, CreatedBy: "user-1"})
+		if err != nil {
+			t.Fatalf("IssueAPIKey: %v", err)
+		}
+		return resp
+	}
+
+	tests := []struct {
+		name string
+		// key выпускает ключ и готовит его к проверке, возвращая строку, которую предъявит клиент
+		key  func(t *testing.T) string
+		want codes.Code
+	}{
+		{"valid", func(t *testing.T) string { return issue(t).Key }, codes.OK},
+		{"other prefix", func(t *testing.T) string { return "sk_" + strings.TrimPrefix(issue(t).Key, apiKeyPrefix) }, codes.Unauthenticated},
+		{"unknown key", func(t *testing.T) string { return apiKeyPrefix + "unknown" }, codes.Unauthenticated},
+		{"revoked", func(t *testing.T) string {
+			resp := issue(t)
+			if _, err := s.RevokeAPIKey(admin, &proto.RevokeAPIKeyRequest{Id: resp.ApiKey.Id}); err != nil {
+				t.Fatalf("RevokeAPIKey: %v", err)
+			}
+			return resp.Key
+		}, codes.Unauthenticated},
+		{"expired", func(t *testing.T) string {
+			resp := issue(t)
+			client.APIKey.UpdateOneID(resp.ApiKey.Id).SetExpiresAt(time.Now().Add(-time.Second)).ExecX(ctx)
+			return resp.Key
+		}, codes.Unauthenticated},
+		{"not yet expired", func(t *testing.T) string {
+			resp := issue(t)
+			client.APIKey.UpdateOneID(resp.ApiKey.Id).SetExpiresAt(time.Now().Add(time.Hour)).ExecX(ctx)
+			return resp.Key
+		}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := s.ValidateAPIKey(ctx, &proto.ValidateAPIKeyRequest{Key: tt.key(t)})
+			if status.Code(err) != tt.want {
+				t.Fatalf("ValidateAPIKey error = %v, want %v", err, tt.want)
+			}
+			if err == nil && key.LastUsedAt == "" {
+				t.Error("last_used_at was not set")
+			}
+		})
+	}
+}
+
+func TestIssueAPIKey(t *testing.T) {
+	_, client := newTestService(t)
+	s := NewAPIKeyService(client, zap.NewNop())
+	admin := asUser("user-1", "admin")
+	maxTTL := int64(maxAPIKeyTTL / time.Second)
+
+	tests := []struct {
+		name        string
+		req         *proto.IssueAPIKeyRequest
+		want        codes.Code
+		wantExpires bool
+	}{
+		{"no ttl", &proto.IssueAPIKeyRequest{Name: "ci"}, codes.OK, false},
+		{"max ttl", &proto.IssueAPIKeyRequest{Name: "ci", TtlSeconds: maxTTL}, codes.OK, true},
+		{"ttl too long", &proto.IssueAPIKeyRequest{Name: "ci", TtlSeconds: maxTTL + 1}, codes.InvalidArgument, false},
+		{"negative ttl", &proto.IssueAPIKeyRequest{Name: "ci", TtlSeconds: -1}, codes.InvalidArgument, false},
+		{"blank name", &proto.IssueAPIKeyRequest{Name: " "}, codes.InvalidArgument, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.IssueAPIKey(admin, tt.req)
+			if status.Code(err) != tt.want {
+				t.Fatalf("IssueAPIKey error = %v, want %v", err, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			if !strings.HasPrefix(resp.Key, resp.ApiKey.Prefix) || !strings.HasPrefix(resp.ApiKey.Prefix, apiKeyPrefix) {
+				t.Errorf("key %q does not start with prefix %q", resp.Key, resp.ApiKey.Prefix)
+			}
+			if (resp.ApiKey.ExpiresAt != "") != tt.wantExpires {
+				t.Errorf("expires_at = %q, want set = %v", resp.ApiKey.ExpiresAt, tt.wantExpires)
+			}
+			// В базе хранится только хэш ключа
+			stored := client.APIKey.GetX(context.Background(), resp.ApiKey.Id)
+			if stored.KeyHash != hashAPIKey(resp.Key) {
+				t.Errorf("stored hash = %s, want hash of the issued key", stored.KeyHash)
+			}
+		})
+	}
+}
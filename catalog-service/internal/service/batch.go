@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// errBatchAborted прерывает транзакцию атомарного пакета после ошибки в одном из элементов
+var errBatchAborted = errors.New("batch aborted")
+
+func (s *CatalogService) BatchGetItems(ctx context.Context, req *proto.BatchGetItemsRequest) (*proto.BatchGetItemsResponse, error) {
+	if err := validateBatchGetItems(req); err != nil {
+		return nil, err
+	}
+
+	items, err := s.client.Item.Query().Where(item.IDIn(req.Ids...)).All(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to fetch items", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fetch items")
+	}
+	byID := make(map[string]*ent.Item, len(items))
+	for _, itm := range items {
+		byID[itm.ID] = itm
+	}
+
+	resp := &proto.BatchGetItemsResponse{}
+	for _, id := range req.Ids {
+		if itm, ok := byID[id]; ok {
+			resp.Items = append(resp.Items, toProtoItem(itm))
+		} else {
+			resp.MissingIds = append(resp.MissingIds, id)
+		}
+	}
+	return resp, nil
+}
+
+// BatchCreateItems создаёт элементы одним INSERT. Некорректные элементы отклоняются
+// до обращения к базе: в атомарном режиме вместе со всем пакетом, иначе - по отдельности.
+func (s *CatalogService) BatchCreateItems(ctx context.Context, req *proto.BatchCreateItemsRequest) (*proto.BatchItemsResponse, error) {
+	if err := validateBatchSize(len(req.Items)); err != nil {
+		return nil, err
+	}
+
+	results := make([]*proto.BatchResult, len(req.Items))
+	var valid []int
+	for i, r := range req.Items {
+		if err := validateCreateItem(r); err != nil {
+			results[i] = batchError(i, "", err)
+			continue
+		}
+		valid = append(valid, i)
+	}
+	if len(valid) == 0 || (req.Mode == proto.BatchMode_BATCH_MODE_ATOMIC && len(valid) < len(req.Items)) {
+		return batchResponse(abortBatch(results, nil)), nil
+	}
+
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		items, err := tx.Item.MapCreateBulk(valid, func(c *ent.ItemCreate, j int) {
+			r := req.Items[valid[j]]
+			c.SetTitle(strings.TrimSpace(r.Title)).
+				SetDescription(r.Description).
+				SetTags(normalizeTags(r.Tags)).
+				SetImageURL(r.ImageUrl)
+		}).Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		for j, itm := range items {
+			if err := enqueueItemEvent(ctx, tx, EventItemCreated, itm); err != nil {
+				return err
+			}
+			results[valid[j]] = batchSuccess(valid[j], itm)
+		}
+		return nil
+	})
+	if err != nil {
+		// Ошибки схемы ent нельзя отнести к конкретному элементу пакета
+		if ent.IsValidationError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.log(ctx).Error("Failed to create items", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to create items")
+	}
+
+	return batchResponse(results), nil
+}
+
+// BatchUpdateItems обновляет элементы в одной транзакции. Ошибки отдельных элементов
+// (нет элемента, другая версия) в режиме best effort не мешают применить остальные.
+func (s *CatalogService) BatchUpdateItems(ctx context.Context, req *proto.BatchUpdateItemsRequest) (*proto.BatchItemsResponse, error) {
+	if err := validateBatchSize(len(req.Items)); err != nil {
+		return nil, err
+	}
+
+	paths := make([][]string, len(req.Items))
+	results := make([]*proto.BatchResult, len(req.Items))
+	var valid []int
+	for i, r := range req.Items {
+		paths[i] = itemUpdatePaths(r)
+		if err := validateUpdateItem(r, paths[i]); err != nil {
+			results[i] = batchError(i, r.Id, err)
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	ids := make([]string, len(req.Items))
+	for i, r := range req.Items {
+		ids[i] = r.Id
+	}
+	return s.applyBatch(ctx, req.Mode, ids, results, valid, func(tx *ent.Tx, i int) (*ent.Item, error) {
+		r := req.Items[i]
+		itm, err := updateItemTx(ctx, tx, r, paths[i])
+		if err != nil {
+			return nil, s.batchItemError(ctx, tx, err, r.Id, r.ExpectedVersion)
+		}
+		return itm, nil
+	})
+}
+
+func (s *CatalogService) BatchDeleteItems(ctx context.Context, req *proto.BatchDeleteItemsRequest) (*proto.BatchItemsResponse, error) {
+	if err := validateBatchSize(len(req.Items)); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(req.Items))
+	results := make([]*proto.BatchResult, len(req.Items))
+	var valid []int
+	for i, r := range req.Items {
+		ids[i] = r.Id
+		if r.Id == "" {
+			results[i] = batchError(i, "", status.Error(codes.InvalidArgument, "id is required"))
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	return s.applyBatch(ctx, req.Mode, ids, results, valid, func(tx *ent.Tx, i int) (*ent.Item, error) {
+		r := req.Items[i]
+		if err := deleteItemTx(ctx, tx, r); err != nil {
+			return nil, s.batchItemError(ctx, tx, err, r.Id, r.ExpectedVersion)
+		}
+		return nil, nil
+	})
+}
+
+// itemError - ошибка конкретного элемента пакета, в отличие от сбоя всей транзакции
+type itemError struct {
+	err error
+}
+
+func (e *itemError) Error() string {
+	return e.err.Error()
+}
+
+func (s *CatalogService) batchItemError(ctx context.Context, tx *ent.Tx, err error, id string, expected *int64) error {
+	// Версию читаем в той же транзакции: в ней видны изменения предыдущих элементов пакета
+	if st, ok := s.itemMutationError(ctx, tx.Client(), err, id, expected); ok {
+		return &itemError{err: st}
+	}
+	return err
+}
+
+// applyBatch применяет fn к элементам valid в одной транзакции. ids - id элементов запроса для результатов.
+// Ошибки элементов попадают в results; в атомарном режиме первая из них откатывает транзакцию.
+func (s *CatalogService) applyBatch(
+	ctx context.Context,
+	mode proto.BatchMode,
+	ids []string,
+	results []*proto.BatchResult,
+	valid []int,
+	fn func(tx *ent.Tx, i int) (*ent.Item, error),
+) (*proto.BatchItemsResponse, error) {
+	atomic := mode == proto.BatchMode_BATCH_MODE_ATOMIC
+	if len(valid) == 0 || (atomic && len(valid) < len(results)) {
+		return batchResponse(abortBatch(results, ids)), nil
+	}
+
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		for _, i := range valid {
+			itm, err := fn(tx, i)
+			var itemErr *itemError
+			switch {
+			case errors.As(err, &itemErr):
+				results[i] = batchError(i, ids[i], itemErr.err)
+				if atomic {
+					return errBatchAborted
+				}
+			case err != nil:
+				return err
+			default:
+				results[i] = batchSuccess(i, itm)
+				results[i].Id = ids[i]
+			}
+		}
+		return nil
+	})
+	switch {
+	case errors.Is(err, errBatchAborted):
+		return batchResponse(abortBatch(results, ids)), nil
+	case err != nil:
+		s.log(ctx).Error("Failed to apply batch", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to apply batch")
+	}
+	return batchResponse(results), nil
+}
+
+// batchSuccess - результат применённого элемента; у удаления itm равен nil
+func batchSuccess(index int, itm *ent.Item) *proto.BatchResult {
+	result := &proto.BatchResult{
+		Index: int32(index),
+		Code:  int32(codes.OK),
+	}
+	if itm != nil {
+		result.Id = itm.ID
+		result.Item = toProtoItem(itm)
+	}
+	return result
+}
+
+func batchError(index int, id string, err error) *proto.BatchResult {
+	st := status.Convert(err)
+	result := &proto.BatchResult{
+		Index:   int32(index),
+		Id:      id,
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				result.Violations = append(result.Violations, &proto.FieldViolation{
+					Field:       v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		case *errdetails.ErrorInfo:
+			result.Reason = d.GetReason()
+		}
+	}
+	return result
+}
+
+// abortBatch помечает элементы, которые не были применены из-за ошибки в другом элементе.
+// ids может быть nil, если у элементов ещё нет id, как при создании.
+func abortBatch(results []*proto.BatchResult, ids []string) []*proto.BatchResult {
+	failed := -1
+	for i, result := range results {
+		if result != nil && codes.Code(result.Code) != codes.OK {
+			failed = i
+			break
+		}
+	}
+	for i, result := range results {
+		if result == nil || codes.Code(result.Code) == codes.OK {
+			aborted := &proto.BatchResult{
+				Index:   int32(i),
+				Code:    int32(codes.Aborted),
+				Message: fmt.Sprintf("not applied because item %d failed", failed),
+			}
+			if ids != nil {
+				aborted.Id = ids[i]
+			}
+			results[i] = aborted
+		}
+	}
+	return results
+}
+
+func batchResponse(results []*proto.BatchResult) *proto.BatchItemsResponse {
+	resp := &proto.BatchItemsResponse{Results: results}
+	for _, result := range results {
+		if codes.Code(result.Code) == codes.OK {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+	return resp
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+const (
+	atomicMode     = proto.BatchMode_BATCH_MODE_ATOMIC
+	bestEffortMode = proto.BatchMode_BATCH_MODE_BEST_EFFORT
+)
+
+func resultCodes(resp *proto.BatchItemsResponse) []codes.Code {
+	got := make([]codes.Code, len(resp.Results))
+	for i, result := range resp.Results {
+		got[i] = codes.Code(result.Code)
+	}
+	return got
+}
+
+func TestBatchCreateItems(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      proto.BatchMode
+		titles    []string
+		wantCodes []codes.Code
+		wantItems int
+	}{
+		{"atomic, all valid", atomicMode, []string{"a", "b"}, []codes.Code{codes.OK, codes.OK}, 2},
+		{"atomic, one invalid", atomicMode, []string{"a", "", "c"}, []codes.Code{codes.Aborted, codes.InvalidArgument, codes.Aborted}, 0},
+		{"best effort, one invalid", bestEffortMode, []string{"a", "", "c"}, []codes.Code{codes.OK, codes.InvalidArgument, codes.OK}, 2},
+		{"best effort, all invalid", bestEffortMode, []string{"", " "}, []codes.Code{codes.InvalidArgument, codes.InvalidArgument}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, client := newTestService(t)
+			ctx := context.Background()
+
+			req := &proto.BatchCreateItemsRequest{Mode: tt.mode}
+			for _, title := range tt.titles {
+				req.Items = append(req.Items, &proto.CreateItemRequest{Title: title})
+			}
+			resp, err := s.BatchCreateItems(ctx, req)
+			if err != nil {
+				t.Fatalf("BatchCreateItems: %v", err)
+			}
+			if got := resultCodes(resp); !reflect.DeepEqual(got, tt.wantCodes) {
+				t.Errorf("result codes = %v, want %v", got, tt.wantCodes)
+			}
+
+			items := client.Item.Query().CountX(ctx)
+			events := client.OutboxEvent.Query().CountX(ctx)
+			if items != tt.wantItems || events != tt.wantItems {
+				t.Errorf("stored %d items and %d events, want %d of each", items, events, tt.wantItems)
+			}
+		})
+	}
+}
+
+func TestBatchUpdateAndDeleteItems(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      proto.BatchMode
+		delete    bool
+		wantCodes []codes.Code
+		// wantTitles - заголовки первого и третьего элементов после пакета, "" - элемент удалён
+		wantTitles []string
+	}{
+		{
+			name:       "atomic update rolls back applied items",
+			mode:       atomicMode,
+			wantCodes:  []codes.Code{codes.Aborted, codes.NotFound, codes.Aborted},
+			wantTitles: []string{"first", "third"},
+		},
+		{
+			name:       "best effort update skips failed item",
+			mode:       bestEffortMode,
+			wantCodes:  []codes.Code{codes.OK, codes.NotFound, codes.OK},
+			wantTitles: []string{"updated", "updated"},
+		},
+		{
+			name:       "atomic delete rolls back applied items",
+			mode:       atomicMode,
+			delete:     true,
+			wantCodes:  []codes.Code{codes.Aborted, codes.NotFound, codes.Aborted},
+			wantTitles: []string{"first", "third"},
+		},
+		{
+			name:       "best effort delete skips failed item",
+			mode:       bestEffortMode,
+			delete:     true,
+			wantCodes:  []codes.Code{codes.OK, codes.NotFound, codes.OK},
+			wantTitles: []string{"", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, client := newTestService(t)
+			ctx := context.Background()
+			first := client.Item.Create().SetTitle("first").SaveX(ctx)
+			third := client.Item.Create().SetTitle("third").SaveX(ctx)
+			ids := []string{first.ID, "missing", third.ID}
+
+			var (
+				resp *proto.BatchItemsResponse
+				err  error
+			)
+			if tt.delete {
+				req := &proto.BatchDeleteItemsRequest{Mode: tt.mode}
+				for _, id := range ids {
+					req.Items = append(req.Items, &proto.DeleteItemRequest{Id: id})
+				}
+				resp, err = s.BatchDeleteItems(ctx, req)
+			} else {
+				req := &proto.BatchUpdateItemsRequest{Mode: tt.mode}
+				for _, id := range ids {
+					req.Items = append(req.Items, &proto.UpdateItemRequest{Id: id, Title: stringPtr("updated")})
+				}
+				resp, err = s.BatchUpdateItems(ctx, req)
+			}
+			if err != nil {
+				t.Fatalf("batch: %v", err)
+			}
+			if got := resultCodes(resp); !reflect.DeepEqual(got, tt.wantCodes) {
+				t.Errorf("result codes = %v, want %v", got, tt.wantCodes)
+			}
+			for i, result := range resp.Results {
+				if result.Id != ids[i] {
+					t.Errorf("results[%d].id = %q, want %q", i, result.Id, ids[i])
+				}
+			}
+
+			for i, id := range []string{first.ID, third.ID} {
+				var title string
+				if itm, err := client.Item.Get(ctx, id); err == nil {
+					title = itm.Title
+				}
+				if title != tt.wantTitles[i] {
+					t.Errorf("item %s title = %q, want %q", id, title, tt.wantTitles[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBatchErrorReason(t *testing.T) {
+	result := batchError(1, "i1", errVersionMismatch(2, 3))
+	if codes.Code(result.Code) != codes.FailedPrecondition || result.Reason != ReasonVersionMismatch {
+		t.Errorf("result code = %v, reason = %q, want FailedPrecondition, %q", codes.Code(result.Code), result.Reason, ReasonVersionMismatch)
+	}
+	if result := batchError(1, "i1", status.Error(codes.NotFound, "item not found")); result.Reason != "" {
+		t.Errorf("reason = %q, want empty", result.Reason)
+	}
+}
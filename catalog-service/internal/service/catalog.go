@@ -2,79 +2,138 @@ package service
 
 import (
 	"context"
+	"errors"
 	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
 	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/category"
 	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/schema"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/logging"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/watch"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strings"
 	"time"
 )
 
 type CatalogService struct {
 	proto.UnimplementedCatalogServiceServer
-	client *ent.Client
-	logger *zap.Logger
+	client  *ent.Client
+	logger  *zap.Logger
+	cursors *cursorCodec
+	hub     *watch.Hub
 }
 
-func NewCatalogService(client *ent.Client, logger *zap.Logger) *CatalogService {
+func NewCatalogService(client *ent.Client, logger *zap.Logger, cursorSecret []byte, hub *watch.Hub) *CatalogService {
 	return &CatalogService{
-		client: client,
-		logger: logger,
+		client:  client,
+		logger:  logger,
+		cursors: newCursorCodec(cursorSecret),
+		hub:     hub,
 	}
 }
 
+// log возвращает логгер текущего запроса с request_id, а вне запроса - логгер сервиса
+func (s *CatalogService) log(ctx context.Context) *zap.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
 func (s *CatalogService) GetItems(ctx context.Context, req *proto.GetItemsRequest) (*proto.GetItemsResponse, error) {
-	query := s.client.Item.Query()
+	if err := validateGetItems(req); err != nil {
+		return nil, err
+	}
 
-	if len(req.Tags) > 0 {
-		query = query.Where(item.TagsNotNil())
+	sortKeys, err := parseSort(req.SortBy)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	total, err := query.Count(ctx)
+	query := s.client.Item.Query()
+
+	// Элементы хранят теги в каноническом написании, поэтому фильтр приводится к нему же
+	tags, err := s.canonicalTags(ctx, normalizeTags(req.Tags))
 	if err != nil {
-		s.logger.Error("Failed to count items", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to count items")
+		s.log(ctx).Error("Failed to resolve tags", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fetch items")
+	}
+	if len(tags) > 0 {
+		pred, ok := tagsPredicate(req.TagMode, tags)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown tag match mode")
+		}
+		query = query.Where(pred)
+	}
+	if req.Category != "" {
+		ids, err := s.categoryFilterIDs(ctx, req.Category, req.IncludeDescendants)
+		if err != nil {
+			return nil, s.categoryError(ctx, err, "", "failed to fetch items")
+		}
+		query = query.Where(item.HasCategoriesWith(category.IDIn(ids...)))
+	}
+	filter := filterFingerprint(req.TagMode, tags, req.Category, req.IncludeDescendants)
+
+	var total int
+	if !req.SkipTotal {
+		total, err = query.Clone().Count(ctx)
+		if err != nil {
+			s.log(ctx).Error("Failed to count items", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to count items")
+		}
 	}
 
 	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 10
 	}
-	offset := int(req.Page-1) * limit
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	if req.Cursor != "" {
+		values, lastID, err := s.cursors.decode(req.Cursor, sortKeys, filter)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		query = query.Where(keysetPredicate(sortKeys, values, lastID))
+	} else {
+		query = query.Offset(int(page-1) * limit)
+	}
 
+	// Запрашиваем на один элемент больше, чтобы понять, есть ли следующая страница
 	items, err := query.
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc(item.FieldCreatedAt)). // По умолчанию сортируем по дате создания
+		Limit(limit + 1).
+		Order(orderOptions(sortKeys)...).
 		All(ctx)
 
 	if err != nil {
-		s.logger.Error("Failed to fetch items", zap.Error(err))
+		s.log(ctx).Error("Failed to fetch items", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to fetch items")
 	}
 
+	var nextCursor string
+	if len(items) > limit {
+		items = items[:limit]
+		nextCursor, err = s.cursors.encode(sortKeys, filter, items[len(items)-1])
+		if err != nil {
+			s.log(ctx).Error("Failed to encode cursor", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to encode cursor")
+		}
+	}
+
 	// Преобразуем элементы в proto формат
 	protoItems := make([]*proto.Item, len(items))
 	for i, itm := range items {
-		protoItems[i] = &proto.Item{
-			Id:          itm.ID,
-			Title:       itm.Title,
-			Description: itm.Description,
-			Tags:        itm.Tags,
-			ImageUrl:    itm.ImageURL,
-			Rating:      itm.Rating,
-			ReviewCount: int32(itm.ReviewCount),
-			CreatedAt:   itm.CreatedAt.Format(time.RFC3339),
-			UpdatedAt:   itm.UpdatedAt.Format(time.RFC3339),
-		}
+		protoItems[i] = toProtoItem(itm)
 	}
 
 	return &proto.GetItemsResponse{
 		Items:      protoItems,
 		Total:      int32(total),
-		Page:       req.Page,
+		Page:       page,
 		TotalPages: int32((total + limit - 1) / limit),
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -84,69 +143,155 @@ func (s *CatalogService) GetItem(ctx context.Context, req *proto.GetItemRequest)
 		if ent.IsNotFound(err) {
 			return nil, status.Error(codes.NotFound, "itm not found")
 		}
-		s.logger.Error("Failed to get itm", zap.Error(err))
+		s.log(ctx).Error("Failed to get itm", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get itm")
 	}
 
-	return &proto.Item{
-		Id:          itm.ID,
-		Title:       itm.Title,
-		Description: itm.Description,
-		Tags:        itm.Tags,
-		ImageUrl:    itm.ImageURL,
-		Rating:      itm.Rating,
-		ReviewCount: int32(itm.ReviewCount),
-		CreatedAt:   itm.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   itm.UpdatedAt.Format(time.RFC3339),
-	}, nil
+	return toProtoItem(itm), nil
 }
 
 func (s *CatalogService) CreateItem(ctx context.Context, req *proto.CreateItemRequest) (*proto.Item, error) {
-	itm, err := s.client.Item.Create().
-		SetTitle(req.Title).
-		SetDescription(req.Description).
-		SetTags(req.Tags).
-		SetImageURL(req.ImageUrl).
-		Save(ctx)
+	if err := validateCreateItem(req); err != nil {
+		return nil, err
+	}
 
+	// Элемент, его первая ревизия и событие в outbox сохраняются в одной транзакции
+	var itm *ent.Item
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		itm, err = tx.Item.Create().
+			SetTitle(strings.TrimSpace(req.Title)).
+			SetDescription(req.Description).
+			SetTags(normalizeTags(req.Tags)).
+			SetImageURL(req.ImageUrl).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return enqueueItemEvent(ctx, tx, EventItemCreated, itm)
+	})
 	if err != nil {
-		s.logger.Error("Failed to create itm", zap.Error(err))
+		// Правила схемы ent дублируют validate.go; если они разошлись, это всё равно ошибка клиента
+		if ent.IsValidationError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.log(ctx).Error("Failed to create itm", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to create itm")
 	}
 
-	return &proto.Item{
-		Id:          itm.ID,
-		Title:       itm.Title,
-		Description: itm.Description,
-		Tags:        itm.Tags,
-		ImageUrl:    itm.ImageURL,
-		Rating:      itm.Rating,
-		ReviewCount: int32(itm.ReviewCount),
-		CreatedAt:   itm.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   itm.UpdatedAt.Format(time.RFC3339),
-	}, nil
+	return toProtoItem(itm), nil
 }
 
 func (s *CatalogService) UpdateItem(ctx context.Context, req *proto.UpdateItemRequest) (*proto.Item, error) {
-	builder := s.client.Item.UpdateOneID(req.Id)
-
-	if req.Tags != nil {
-		builder.SetTags(req.Tags)
+	paths := itemUpdatePaths(req)
+	if err := validateUpdateItem(req, paths); err != nil {
+		return nil, err
 	}
-	if req.Title != nil {
-		builder.SetTitle(*req.Title)
+
+	var itm *ent.Item
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		itm, err = updateItemTx(ctx, tx, req, paths)
+		return err
+	})
+	if err != nil {
+		if st, ok := s.itemMutationError(ctx, s.client, err, req.Id, req.ExpectedVersion); ok {
+			return nil, st
+		}
+		s.log(ctx).Error("Failed to update itm", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to update itm")
 	}
-	if req.Description != nil {
-		builder.SetDescription(*req.Description)
+	return toProtoItem(itm), nil
+}
+
+// updateItemTx обновляет поля из paths в транзакции tx и пишет событие в outbox
+func updateItemTx(ctx context.Context, tx *ent.Tx, req *proto.UpdateItemRequest, paths []string) (*ent.Item, error) {
+	// Пустая маска - нечего обновлять, updated_at тоже не должен меняться
+	if len(paths) == 0 {
+		itm, err := tx.Item.Get(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if req.ExpectedVersion != nil && itm.Version != *req.ExpectedVersion {
+			return nil, errVersionMismatch(*req.ExpectedVersion, itm.Version)
+		}
+		return itm, nil
 	}
-	if req.ImageUrl != nil {
-		builder.SetImageURL(*req.ImageUrl)
+
+	// Условие на версию и её увеличение выполняются одним UPDATE, поэтому из двух
+	// параллельных изменений одной версии пройдёт только одно
+	builder := tx.Item.UpdateOneID(req.Id).AddVersion(1)
+	if req.ExpectedVersion != nil {
+		builder.Where(item.Version(*req.ExpectedVersion))
 	}
+	applyItemUpdate(builder, req, paths)
+
 	itm, err := builder.Save(ctx)
 	if err != nil {
-		s.logger.Error("Failed to update itm", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to update itm")
+		return nil, err
+	}
+	return itm, enqueueItemEvent(ctx, tx, EventItemUpdated, itm)
+}
+
+func (s *CatalogService) DeleteItem(ctx context.Context, req *proto.DeleteItemRequest) (*proto.DeleteItemResponse, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		return deleteItemTx(ctx, tx, req)
+	})
+	if err != nil {
+		if st, ok := s.itemMutationError(ctx, s.client, err, req.Id, req.ExpectedVersion); ok {
+			return nil, st
+		}
+		s.log(ctx).Error("Failed to delete item", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to delete item")
+	}
+	return &proto.DeleteItemResponse{
+		Success: true,
+	}, nil
+}
+
+// errItemNotDeleted - условие удаления не выполнено: элемента нет или у него другая версия
+var errItemNotDeleted = errors.New("item not deleted")
+
+// deleteItemTx переносит элемент в корзину в транзакции tx и пишет событие в outbox
+func deleteItemTx(ctx context.Context, tx *ent.Tx, req *proto.DeleteItemRequest) error {
+	query := tx.Item.Delete().Where(item.ID(req.Id))
+	if req.ExpectedVersion != nil {
+		query = query.Where(item.Version(*req.ExpectedVersion))
+	}
+
+	deleted, err := query.Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return errItemNotDeleted
+	}
+
+	// Событие несёт состояние элемента в момент удаления, он уже в корзине
+	itm, err := tx.Item.Get(schema.SkipSoftDelete(ctx), req.Id)
+	if err != nil {
+		return err
+	}
+	return enqueueItemEvent(ctx, tx, EventItemDeleted, itm)
+}
+
+// itemMutationError переводит ошибку изменения элемента в gRPC статус.
+// false означает, что ошибка не связана с самим элементом, например недоступна база.
+func (s *CatalogService) itemMutationError(ctx context.Context, client *ent.Client, err error, id string, expected *int64) (error, bool) {
+	if _, ok := status.FromError(err); ok {
+		return err, true
 	}
+	if ent.IsNotFound(err) || errors.Is(err, errItemNotDeleted) {
+		return s.missingItemError(ctx, client, id, expected), true
+	}
+	// Правила схемы ent дублируют validate.go; если они разошлись, это всё равно ошибка клиента
+	if ent.IsValidationError(err) {
+		return status.Error(codes.InvalidArgument, err.Error()), true
+	}
+	return nil, false
+}
+
+func toProtoItem(itm *ent.Item) *proto.Item {
 	return &proto.Item{
 		Id:          itm.ID,
 		Title:       itm.Title,
@@ -157,15 +302,8 @@ func (s *CatalogService) UpdateItem(ctx context.Context, req *proto.UpdateItemRe
 		ReviewCount: int32(itm.ReviewCount),
 		CreatedAt:   itm.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   itm.UpdatedAt.Format(time.RFC3339),
-	}, nil
-}
-
-func (s *CatalogService) DeleteItem(ctx context.Context, req *proto.DeleteItemRequest) (*proto.DeleteItemResponse, error) {
-	if err := s.client.Item.DeleteOneID(req.Id).Exec(ctx); err != nil {
-		s.logger.Error("Failed to delete item", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to delete item")
+		Version:     itm.Version,
+		DeletedAt:   formatOptionalTime(itm.DeletedAt),
+		ExternalId:  stringValue(itm.ExternalID),
 	}
-	return &proto.DeleteItemResponse{
-		Success: true,
-	}, nil
 }
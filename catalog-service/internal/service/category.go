@@ -0,0 +1,528 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/category"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/validation"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxCategoryDepth ограничивает вложенность дерева: глубже навигацией никто не пользуется,
+// а обход предков и потомков остаётся дешёвым
+const maxCategoryDepth = 10
+
+// updatableCategoryFields - поля Category, которые можно указать в update_mask
+var updatableCategoryFields = []string{"name", "slug", "description", "parent_id", "position"}
+
+var errParentNotFound = errors.New("parent category not found")
+
+func (s *CatalogService) CreateCategory(ctx context.Context, req *proto.CreateCategoryRequest) (*proto.Category, error) {
+	slug := req.Slug
+	if slug == "" {
+		slug = slugify(req.Name)
+	}
+	if err := validateCreateCategory(req, slug); err != nil {
+		return nil, err
+	}
+
+	var cat *ent.Category
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		builder := tx.Category.Create().
+			SetName(strings.TrimSpace(req.Name)).
+			SetSlug(slug).
+			SetDescription(req.Description).
+			SetPosition(int(req.Position))
+		if req.ParentId != "" {
+			ancestors, err := categoryAncestors(ctx, tx, req.ParentId)
+			if err != nil {
+				return err
+			}
+			if len(ancestors)+1 > maxCategoryDepth {
+				return errInvalidParent("categories can be nested at most %d levels deep", maxCategoryDepth)
+			}
+			builder.SetParentID(req.ParentId)
+		}
+
+		var err error
+		cat, err = builder.Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, s.categoryError(ctx, err, slug, "failed to create category")
+	}
+	return toProtoCategory(cat), nil
+}
+
+func (s *CatalogService) GetCategory(ctx context.Context, req *proto.GetCategoryRequest) (*proto.Category, error) {
+	cat, err := findCategory(ctx, s.client, req.Id)
+	if err != nil {
+		return nil, s.categoryError(ctx, err, "", "failed to fetch category")
+	}
+	return toProtoCategory(cat), nil
+}
+
+// UpdateCategory меняет поля категории. Перенос в другую ветку проверяет, что категория
+// не попадает в собственное поддерево и дерево не становится глубже maxCategoryDepth.
+func (s *CatalogService) UpdateCategory(ctx context.Context, req *proto.UpdateCategoryRequest) (*proto.Category, error) {
+	paths := categoryUpdatePaths(req)
+	if err := validateUpdateCategory(req, paths); err != nil {
+		return nil, err
+	}
+
+	var cat *ent.Category
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if _, err := tx.Category.Query().Where(category.ID(req.Id)).ForUpdate().Only(ctx); err != nil {
+			return err
+		}
+
+		builder := tx.Category.UpdateOneID(req.Id)
+		for _, path := range paths {
+			switch path {
+			case "name":
+				builder.SetName(strings.TrimSpace(req.GetName()))
+			case "slug":
+				builder.SetSlug(req.GetSlug())
+			case "description":
+				builder.SetDescription(req.GetDescription())
+			case "position":
+				builder.SetPosition(int(req.GetPosition()))
+			case "parent_id":
+				if req.GetParentId() == "" {
+					builder.ClearParentID()
+					continue
+				}
+				if err := checkCategoryMove(ctx, tx, req.Id, req.GetParentId()); err != nil {
+					return err
+				}
+				builder.SetParentID(req.GetParentId())
+			}
+		}
+
+		var err error
+		cat, err = builder.Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, s.categoryError(ctx, err, req.GetSlug(), "failed to update category")
+	}
+	return toProtoCategory(cat), nil
+}
+
+// checkCategoryMove проверяет перенос категории id под parentID
+func checkCategoryMove(ctx context.Context, tx *ent.Tx, id, parentID string) error {
+	if parentID == id {
+		return errInvalidParent("category cannot be its own parent")
+	}
+	ancestors, err := categoryAncestors(ctx, tx, parentID)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(ancestors, id) {
+		return errInvalidParent("category cannot be moved into its own subcategory")
+	}
+	_, height, err := categoryDescendants(ctx, tx.Client(), []string{id})
+	if err != nil {
+		return err
+	}
+	if len(ancestors)+1+height > maxCategoryDepth {
+		return errInvalidParent("categories can be nested at most %d levels deep", maxCategoryDepth)
+	}
+	return nil
+}
+
+// errInvalidParent описывает недопустимого родителя как ошибку поля parent_id:
+// запрос неверен при любом состоянии дерева, которое клиент видел
+func errInvalidParent(format string, args ...any) error {
+	var v validation.Errors
+	v.Add("parent_id", format, args...)
+	return v.Err()
+}
+
+// DeleteCategory удаляет категорию без подкатегорий. Элементы остаются в каталоге,
+// удаляется только их связь с категорией.
+func (s *CatalogService) DeleteCategory(ctx context.Context, req *proto.DeleteCategoryRequest) (*proto.DeleteCategoryResponse, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if _, err := tx.Category.Query().Where(category.ID(req.Id)).ForUpdate().Only(ctx); err != nil {
+			return err
+		}
+		hasChildren, err := tx.Category.Query().Where(category.ParentID(req.Id)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if hasChildren {
+			return status.Error(codes.FailedPrecondition, "category has subcategories, move or delete them first")
+		}
+		return tx.Category.DeleteOneID(req.Id).Exec(ctx)
+	})
+	if err != nil {
+		return nil, s.categoryError(ctx, err, "", "failed to delete category")
+	}
+	return &proto.DeleteCategoryResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *CatalogService) ListCategories(ctx context.Context, req *proto.ListCategoriesRequest) (*proto.ListCategoriesResponse, error) {
+	if err := validateListCategories(req); err != nil {
+		return nil, err
+	}
+
+	query := s.client.Category.Query()
+	if req.ParentId != "" {
+		exists, err := s.client.Category.Query().Where(category.ID(req.ParentId)).Exist(ctx)
+		if err != nil {
+			s.log(ctx).Error("Failed to check category", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list categories")
+		}
+		if !exists {
+			return nil, status.Error(codes.NotFound, "category not found")
+		}
+		query = query.Where(category.ParentID(req.ParentId))
+	} else {
+		query = query.Where(category.ParentIDIsNil())
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to count categories", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to count categories")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+
+	categories, err := query.
+		Limit(limit).
+		Offset((page - 1) * limit).
+		Order(categoryOrder()...).
+		All(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to fetch categories", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fetch categories")
+	}
+
+	protoCategories := make([]*proto.Category, len(categories))
+	for i, cat := range categories {
+		protoCategories[i] = toProtoCategory(cat)
+	}
+
+	return &proto.ListCategoriesResponse{
+		Categories: protoCategories,
+		Total:      int32(total),
+		Page:       int32(page),
+		TotalPages: int32((total + limit - 1) / limit),
+	}, nil
+}
+
+// GetCategoryTree строит дерево за один запрос: категорий на порядки меньше, чем элементов,
+// и обход по уровням обошёлся бы дороже
+func (s *CatalogService) GetCategoryTree(ctx context.Context, req *proto.GetCategoryTreeRequest) (*proto.GetCategoryTreeResponse, error) {
+	if err := validateGetCategoryTree(req); err != nil {
+		return nil, err
+	}
+
+	var root *ent.Category
+	if req.Root != "" {
+		var err error
+		root, err = findCategory(ctx, s.client, req.Root)
+		if err != nil {
+			return nil, s.categoryError(ctx, err, "", "failed to fetch category tree")
+		}
+	}
+
+	categories, err := s.client.Category.Query().Order(categoryOrder()...).All(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to fetch categories", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fetch category tree")
+	}
+
+	children := make(map[string][]*ent.Category)
+	var roots []*ent.Category
+	for _, cat := range categories {
+		if cat.ParentID == nil {
+			roots = append(roots, cat)
+			continue
+		}
+		children[*cat.ParentID] = append(children[*cat.ParentID], cat)
+	}
+	if root != nil {
+		roots = []*ent.Category{root}
+	}
+
+	maxDepth := int(req.MaxDepth)
+	if maxDepth <= 0 || maxDepth > maxCategoryDepth {
+		maxDepth = maxCategoryDepth
+	}
+	var build func(cat *ent.Category, depth int) *proto.CategoryNode
+	build = func(cat *ent.Category, depth int) *proto.CategoryNode {
+		node := &proto.CategoryNode{Category: toProtoCategory(cat)}
+		if depth < maxDepth {
+			for _, child := range children[cat.ID] {
+				node.Children = append(node.Children, build(child, depth+1))
+			}
+		}
+		return node
+	}
+
+	resp := &proto.GetCategoryTreeResponse{}
+	for _, cat := range roots {
+		resp.Roots = append(resp.Roots, build(cat, 0))
+	}
+	return resp, nil
+}
+
+func (s *CatalogService) AddCategoryItems(ctx context.Context, req *proto.CategoryItemsRequest) (*proto.CategoryItemsResponse, error) {
+	return s.changeCategoryItems(ctx, req, func(tx *ent.Tx, ids []string) error {
+		// Связи, которые уже есть, не вставляем повторно: это нарушило бы первичный ключ
+		linked, err := tx.Category.Query().
+			Where(category.ID(req.CategoryId)).
+			QueryItems().
+			Where(item.IDIn(ids...)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		ids = slices.DeleteFunc(ids, func(id string) bool {
+			return slices.Contains(linked, id)
+		})
+		if len(ids) == 0 {
+			return nil
+		}
+		return tx.Category.UpdateOneID(req.CategoryId).AddItemIDs(ids...).Exec(ctx)
+	})
+}
+
+func (s *CatalogService) RemoveCategoryItems(ctx context.Context, req *proto.CategoryItemsRequest) (*proto.CategoryItemsResponse, error) {
+	return s.changeCategoryItems(ctx, req, func(tx *ent.Tx, ids []string) error {
+		return tx.Category.UpdateOneID(req.CategoryId).RemoveItemIDs(ids...).Exec(ctx)
+	})
+}
+
+// changeCategoryItems применяет fn к существующим элементам из запроса, остальные возвращает в missing_ids
+func (s *CatalogService) changeCategoryItems(ctx context.Context, req *proto.CategoryItemsRequest, fn func(tx *ent.Tx, ids []string) error) (*proto.CategoryItemsResponse, error) {
+	if err := validateCategoryItems(req); err != nil {
+		return nil, err
+	}
+
+	resp := &proto.CategoryItemsResponse{}
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if _, err := tx.Category.Query().Where(category.ID(req.CategoryId)).ForShare().Only(ctx); err != nil {
+			return err
+		}
+
+		found, err := tx.Item.Query().Where(item.IDIn(req.ItemIds...)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		var ids []string
+		for _, id := range req.ItemIds {
+			switch {
+			case slices.Contains(ids, id):
+			case slices.Contains(found, id):
+				ids = append(ids, id)
+			case !slices.Contains(resp.MissingIds, id):
+				resp.MissingIds = append(resp.MissingIds, id)
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		return fn(tx, ids)
+	})
+	if err != nil {
+		return nil, s.categoryError(ctx, err, "", "failed to update category items")
+	}
+	return resp, nil
+}
+
+// categoryFilterIDs возвращает id категории для фильтра GetItems и, если нужно, id всех её подкатегорий
+func (s *CatalogService) categoryFilterIDs(ctx context.Context, idOrSlug string, descendants bool) ([]string, error) {
+	cat, err := findCategory(ctx, s.client, idOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{cat.ID}
+	if descendants {
+		children, _, err := categoryDescendants(ctx, s.client, ids)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, children...)
+	}
+	return ids, nil
+}
+
+// findCategory ищет категорию по id, а если такой нет - по slug
+func findCategory(ctx context.Context, client *ent.Client, idOrSlug string) (*ent.Category, error) {
+	categories, err := client.Category.Query().
+		Where(category.Or(category.ID(idOrSlug), category.Slug(idOrSlug))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, cat := range categories {
+		if cat.ID == idOrSlug {
+			return cat, nil
+		}
+	}
+	if len(categories) == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+	return categories[0], nil
+}
+
+// categoryAncestors возвращает id категории и всех её предков, начиная с неё самой.
+// Строки блокируются до конца транзакции, чтобы параллельный перенос или удаление
+// не изменили цепочку между проверкой и записью.
+func categoryAncestors(ctx context.Context, tx *ent.Tx, id string) ([]string, error) {
+	var ancestors []string
+	for current := &id; current != nil; {
+		if len(ancestors) > maxCategoryDepth {
+			return nil, status.Error(codes.Internal, "category tree is deeper than allowed")
+		}
+		cat, err := tx.Category.Query().
+			Where(category.ID(*current)).
+			ForShare().
+			Only(ctx)
+		if ent.IsNotFound(err) && len(ancestors) == 0 {
+			return nil, errParentNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, cat.ID)
+		current = cat.ParentID
+	}
+	return ancestors, nil
+}
+
+// categoryDescendants обходит поддеревья ids по уровням и возвращает id всех подкатегорий
+// и число уровней под ids
+func categoryDescendants(ctx context.Context, client *ent.Client, ids []string) ([]string, int, error) {
+	var descendants []string
+	height := 0
+	for frontier := ids; len(frontier) > 0; height++ {
+		if height > maxCategoryDepth {
+			return nil, 0, status.Error(codes.Internal, "category tree is deeper than allowed")
+		}
+		children, err := client.Category.Query().
+			Where(category.ParentIDIn(frontier...)).
+			IDs(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		descendants = append(descendants, children...)
+		frontier = children
+	}
+	// Последний уровень цикла пустой и уровнем не считается
+	return descendants, height - 1, nil
+}
+
+// categoryUpdatePaths работает так же, как itemUpdatePaths
+func categoryUpdatePaths(req *proto.UpdateCategoryRequest) []string {
+	if req.UpdateMask == nil {
+		var paths []string
+		if req.Name != nil {
+			paths = append(paths, "name")
+		}
+		if req.Slug != nil {
+			paths = append(paths, "slug")
+		}
+		if req.Description != nil {
+			paths = append(paths, "description")
+		}
+		if req.ParentId != nil {
+			paths = append(paths, "parent_id")
+		}
+		if req.Position != nil {
+			paths = append(paths, "position")
+		}
+		return paths
+	}
+
+	for _, path := range req.UpdateMask.Paths {
+		if path == "*" {
+			return updatableCategoryFields
+		}
+	}
+	return req.UpdateMask.Paths
+}
+
+// slugify строит slug из латинских букв и цифр названия, остальные символы заменяются дефисом.
+// Для названия без латиницы slug получится пустым, и его нужно задать явно.
+func slugify(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			dash = true
+			continue
+		}
+		if dash && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		dash = false
+		b.WriteRune(r)
+	}
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
+
+func categoryOrder() []category.OrderOption {
+	return []category.OrderOption{
+		category.ByPosition(),
+		category.ByName(),
+		category.ByID(),
+	}
+}
+
+// categoryError переводит ошибку операции с категорией в gRPC статус
+func (s *CatalogService) categoryError(ctx context.Context, err error, slug, message string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, errParentNotFound):
+		return status.Error(codes.NotFound, "parent category not found")
+	case ent.IsNotFound(err):
+		return status.Error(codes.NotFound, "category not found")
+	case ent.IsConstraintError(err):
+		// Родитель защищён блокировкой, поэтому нарушить можно только уникальность slug
+		return status.Errorf(codes.AlreadyExists, "category with slug %q already exists", slug)
+	case ent.IsValidationError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.log(ctx).Error("Failed to change category", zap.String("operation", message), zap.Error(err))
+	return status.Error(codes.Internal, message)
+}
+
+func toProtoCategory(cat *ent.Category) *proto.Category {
+	return &proto.Category{
+		Id:          cat.ID,
+		Name:        cat.Name,
+		Slug:        cat.Slug,
+		Description: cat.Description,
+		ParentId:    stringValue(cat.ParentID),
+		Position:    int32(cat.Position),
+		CreatedAt:   cat.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   cat.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/testdb"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// newCategoryTestService открывает сервис поверх PostgreSQL: изменения дерева блокируют строки
+// через FOR UPDATE и FOR SHARE, которых нет в SQLite
+func newCategoryTestService(t *testing.T) (*CatalogService, *ent.Client) {
+	t.Helper()
+	client := testdb.Open(t)
+	return NewCatalogService(client, zap.NewNop(), []byte("test-secret"), nil), client
+}
+
+// createCategoryChain создаёт цепочку из n вложенных категорий и возвращает их id от корня
+func createCategoryChain(t *testing.T, client *ent.Client, prefix string, n int) []string {
+	t.Helper()
+	ctx := context.Background()
+	ids := make([]string, n)
+	var parent *string
+	for i := range ids {
+		cat := client.Category.Create().
+			SetName(fmt.Sprintf("%s %d", prefix, i)).
+			SetSlug(fmt.Sprintf("%s-%d", prefix, i)).
+			SetNillableParentID(parent).
+			SaveX(ctx)
+		ids[i] = cat.ID
+		parent = &ids[i]
+	}
+	return ids
+}
+
+// parentViolation проверяет, что err - InvalidArgument с нарушением в поле parent_id
+func parentViolation(t *testing.T, err error) {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("error = %v, want InvalidArgument", err)
+	}
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				if v.GetField() == "parent_id" {
+					return
+				}
+			}
+		}
+	}
+	t.Errorf("error %v has no parent_id violation", err)
+}
+
+func TestCreateCategoryDepth(t *testing.T) {
+	s, client := newCategoryTestService(t)
+	ctx := context.Background()
+	chain := createCategoryChain(t, client, "deep", maxCategoryDepth)
+
+	// Предпоследний уровень ещё может получить подкатегорию, последний - уже нет
+	if _, err := s.CreateCategory(ctx, &proto.CreateCategoryRequest{Name: "ok", ParentId: chain[maxCategoryDepth-2]}); err != nil {
+		t.Fatalf("CreateCategory at max depth: %v", err)
+	}
+	_, err := s.CreateCategory(ctx, &proto.CreateCategoryRequest{Name: "too deep", ParentId: chain[maxCategoryDepth-1]})
+	parentViolation(t, err)
+
+	_, err = s.CreateCategory(ctx, &proto.CreateCategoryRequest{Name: "orphan", ParentId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("missing parent error = %v, want NotFound", err)
+	}
+}
+
+func TestUpdateCategoryParent(t *testing.T) {
+	tests := []struct {
+		name string
+		// move возвращает id переносимой категории и нового родителя
+		move    func(chain, other []string) (id, parentID string)
+		wantErr bool
+	}{
+		{"self parent", func(chain, _ []string) (string, string) { return chain[1], chain[1] }, true},
+		{"into own child", func(chain, _ []string) (string, string) { return chain[0], chain[1] }, true},
+		{"into own descendant", func(chain, _ []string) (string, string) { return chain[1], chain[3] }, true},
+		{"subtree too deep", func(chain, other []string) (string, string) { return chain[0], other[len(other)-1] }, true},
+		{"subtree fits", func(chain, other []string) (string, string) { return chain[2], other[len(other)-1] }, false},
+		{"to sibling branch", func(chain, other []string) (string, string) { return chain[3], other[0] }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, client := newCategoryTestService(t)
+			// chain - 4 уровня; вместе с other глубиной maxCategoryDepth-2 целиком не помещается,
+			// а поддерево из двух нижних уровней помещается
+			chain := createCategoryChain(t, client, "chain", 4)
+			other := createCategoryChain(t, client, "other", maxCategoryDepth-2)
+
+			id, parentID := tt.move(chain, other)
+			cat, err := s.UpdateCategory(context.Background(), &proto.UpdateCategoryRequest{Id: id, ParentId: &parentID})
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("UpdateCategory: %v", err)
+				}
+				if cat.ParentId != parentID {
+					t.Errorf("parent_id = %q, want %q", cat.ParentId, parentID)
+				}
+				return
+			}
+			parentViolation(t, err)
+			// Отклонённый перенос не меняет дерево
+			if got := client.Category.GetX(context.Background(), id); got.ParentID != nil && *got.ParentID == parentID {
+				t.Errorf("category %s was moved under %s", id, parentID)
+			}
+		})
+	}
+}
+
+func TestDeleteCategoryWithChildren(t *testing.T) {
+	s, client := newCategoryTestService(t)
+	ctx := context.Background()
+	chain := createCategoryChain(t, client, "chain", 2)
+
+	_, err := s.DeleteCategory(ctx, &proto.DeleteCategoryRequest{Id: chain[0]})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("delete parent error = %v, want FailedPrecondition", err)
+	}
+	if _, err := s.DeleteCategory(ctx, &proto.DeleteCategoryRequest{Id: chain[1]}); err != nil {
+		t.Errorf("delete leaf: %v", err)
+	}
+}
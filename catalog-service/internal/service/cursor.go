@@ -0,0 +1,198 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"entgo.io/ent/dialect/sql"
+	"errors"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// pageToken - содержимое курсора: значения ключей сортировки и id последнего элемента страницы.
+// Sort и Filter привязывают курсор к запросу, для которого он был выдан.
+type pageToken struct {
+	Sort   string            `json:"s"`
+	Filter string            `json:"f"`
+	Values []json.RawMessage `json:"v"`
+	ID     string            `json:"id"`
+}
+
+// cursorCodec кодирует курсоры в base64 и подписывает их HMAC-SHA256,
+// чтобы клиент не мог подменить значения ключей.
+type cursorCodec struct {
+	secret []byte
+}
+
+func newCursorCodec(secret []byte) *cursorCodec {
+	return &cursorCodec{secret: secret}
+}
+
+func (c *cursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func (c *cursorCodec) encode(keys []sortKey, filter string, itm *ent.Item) (string, error) {
+	token := pageToken{
+		Sort:   sortFingerprint(keys),
+		Filter: filter,
+		Values: make([]json.RawMessage, len(keys)),
+		ID:     itm.ID,
+	}
+	for i, key := range keys {
+		value, err := json.Marshal(sortValue(itm, key.field))
+		if err != nil {
+			return "", err
+		}
+		token.Values[i] = value
+	}
+
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(payload) + "." + enc.EncodeToString(c.sign(payload)), nil
+}
+
+// decode проверяет подпись курсора и возвращает значения ключей сортировки в типах колонок
+func (c *cursorCodec) decode(cursor string, keys []sortKey, filter string) ([]any, string, error) {
+	enc := base64.RawURLEncoding
+	rawPayload, rawSig, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return nil, "", errInvalidCursor
+	}
+	payload, err := enc.DecodeString(rawPayload)
+	if err != nil {
+		return nil, "", errInvalidCursor
+	}
+	sig, err := enc.DecodeString(rawSig)
+	if err != nil || !hmac.Equal(sig, c.sign(payload)) {
+		return nil, "", errInvalidCursor
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(payload, &token); err != nil {
+		return nil, "", errInvalidCursor
+	}
+	if token.Sort != sortFingerprint(keys) || token.Filter != filter {
+		return nil, "", fmt.Errorf("%w: cursor does not match sort or filter parameters", errInvalidCursor)
+	}
+	if len(token.Values) != len(keys) || token.ID == "" {
+		return nil, "", errInvalidCursor
+	}
+
+	values := make([]any, len(keys))
+	for i, key := range keys {
+		value, err := decodeSortValue(key.field, token.Values[i])
+		if err != nil {
+			return nil, "", errInvalidCursor
+		}
+		values[i] = value
+	}
+	return values, token.ID, nil
+}
+
+func sortFingerprint(keys []sortKey) string {
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		if key.desc {
+			parts[i] = "-" + key.field
+		} else {
+			parts[i] = key.field
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// filterFingerprint описывает фильтры запроса, при смене которых курсор становится недействительным
+func filterFingerprint(mode proto.TagMatchMode, tags []string, category string, descendants bool) string {
+	var fingerprint string
+	if len(tags) > 0 {
+		sorted := append([]string(nil), tags...)
+		sort.Strings(sorted)
+		fingerprint = mode.String() + ":" + strings.Join(sorted, ",")
+	}
+	if category != "" {
+		fingerprint += "|category:" + category + ":" + strconv.FormatBool(descendants)
+	}
+	return fingerprint
+}
+
+func sortValue(itm *ent.Item, field string) any {
+	switch field {
+	case item.FieldRating:
+		return itm.Rating
+	case item.FieldReviewCount:
+		return itm.ReviewCount
+	case item.FieldTitle:
+		return itm.Title
+	case item.FieldCreatedAt:
+		return itm.CreatedAt
+	case item.FieldUpdatedAt:
+		return itm.UpdatedAt
+	default:
+		return nil
+	}
+}
+
+func decodeSortValue(field string, raw json.RawMessage) (any, error) {
+	switch field {
+	case item.FieldRating:
+		var v float64
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	case item.FieldReviewCount:
+		var v int
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	case item.FieldTitle:
+		var v string
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	case item.FieldCreatedAt, item.FieldUpdatedAt:
+		var v time.Time
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	default:
+		return nil, fmt.Errorf("unsupported sort field %q", field)
+	}
+}
+
+// keysetPredicate выбирает элементы, идущие после курсора в порядке сортировки:
+// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... OR (k1 = v1 AND ... AND id > last_id).
+// Для ключей по убыванию сравнение меняется на "<".
+func keysetPredicate(keys []sortKey, values []any, lastID string) predicate.Item {
+	return func(s *sql.Selector) {
+		ors := make([]*sql.Predicate, 0, len(keys)+1)
+		for i := 0; i <= len(keys); i++ {
+			ands := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				ands = append(ands, sql.EQ(s.C(keys[j].field), values[j]))
+			}
+			switch {
+			case i == len(keys):
+				ands = append(ands, sql.GT(s.C(item.FieldID), lastID))
+			case keys[i].desc:
+				ands = append(ands, sql.LT(s.C(keys[i].field), values[i]))
+			default:
+				ands = append(ands, sql.GT(s.C(keys[i].field), values[i]))
+			}
+			ors = append(ors, sql.And(ands...))
+		}
+		s.Where(sql.Or(ors...))
+	}
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 30, 0, 123456789, time.UTC)
+	itm := &ent.Item{
+		ID:          "item-1",
+		Title:       "Dune",
+		Rating:      4.5,
+		ReviewCount: 12,
+		CreatedAt:   created,
+		UpdatedAt:   created.Add(time.Hour),
+	}
+
+	tests := []struct {
+		name string
+		keys []sortKey
+		want []any
+	}{
+		{"default", defaultSort, []any{created}},
+		{"rating and title", []sortKey{{field: item.FieldRating, desc: true}, {field: item.FieldTitle}}, []any{4.5, "Dune"}},
+		{"review count", []sortKey{{field: item.FieldReviewCount}}, []any{12}},
+		{"updated at", []sortKey{{field: item.FieldUpdatedAt}}, []any{created.Add(time.Hour)}},
+	}
+
+	codec := newCursorCodec([]byte("secret"))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := codec.encode(tt.keys, "filter", itm)
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+			values, id, err := codec.decode(cursor, tt.keys, "filter")
+			if err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if id != itm.ID {
+				t.Errorf("id = %q, want %q", id, itm.ID)
+			}
+			if len(values) != len(tt.want) {
+				t.Fatalf("got %d values, want %d", len(values), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if wantTime, ok := want.(time.Time); ok {
+					if got, ok := values[i].(time.Time); !ok || !got.Equal(wantTime) {
+						t.Errorf("values[%d] = %v, want %v", i, values[i], want)
+					}
+					continue
+				}
+				if values[i] != want {
+					t.Errorf("values[%d] = %#v, want %#v", i, values[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestCursorRejected(t *testing.T) {
+	keys := []sortKey{{field: item.FieldRating, desc: true}}
+	itm := &ent.Item{ID: "item-1", Rating: 3}
+
+	codec := newCursorCodec([]byte("secret"))
+	cursor, err := codec.encode(keys, "filter", itm)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	payload, sig, _ := strings.Cut(cursor, ".")
+	// Первый символ подписи кодирует полные 6 бит, поэтому его замена всегда меняет подпись
+	tampered := "A" + sig[1:]
+	if sig[0] == 'A' {
+		tampered = "B" + sig[1:]
+	}
+
+	tests := []struct {
+		name   string
+		codec  *cursorCodec
+		cursor string
+		keys   []sortKey
+		filter string
+	}{
+		{"other secret", newCursorCodec([]byte("other")), cursor, keys, "filter"},
+		{"no signature", codec, payload, keys, "filter"},
+		{"tampered payload", codec, payload + "x." + sig, keys, "filter"},
+		{"tampered signature", codec, payload + "." + tampered, keys, "filter"},
+		{"not base64", codec, "!!!.???", keys, "filter"},
+		{"other sort", codec, cursor, []sortKey{{field: item.FieldRating}}, "filter"},
+		{"other filter", codec, cursor, keys, "other"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := tt.codec.decode(tt.cursor, tt.keys, tt.filter)
+			if !errors.Is(err, errInvalidCursor) {
+				t.Errorf("decode error = %v, want errInvalidCursor", err)
+			}
+		})
+	}
+}
+
+func TestFilterFingerprint(t *testing.T) {
+	anyMode := proto.TagMatchMode_TAG_MATCH_MODE_ANY
+	allMode := proto.TagMatchMode_TAG_MATCH_MODE_ALL
+
+	tests := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{"tag order", filterFingerprint(anyMode, []string{"a", "b"}, "", false), filterFingerprint(anyMode, []string{"b", "a"}, "", false), true},
+		{"tag mode", filterFingerprint(anyMode, []string{"a"}, "", false), filterFingerprint(allMode, []string{"a"}, "", false), false},
+		{"tags", filterFingerprint(anyMode, []string{"a"}, "", false), filterFingerprint(anyMode, []string{"b"}, "", false), false},
+		{"category", filterFingerprint(anyMode, nil, "books", false), filterFingerprint(anyMode, nil, "films", false), false},
+		{"descendants", filterFingerprint(anyMode, nil, "books", false), filterFingerprint(anyMode, nil, "books", true), false},
+		{"no filters", filterFingerprint(anyMode, nil, "", false), filterFingerprint(allMode, nil, "", true), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.a == tt.b) != tt.equal {
+				t.Errorf("fingerprints %q and %q: equal = %v, want %v", tt.a, tt.b, tt.a == tt.b, tt.equal)
+			}
+		})
+	}
+}
+
+// TestGetItemsKeysetOrder проходит все страницы по курсору и сравнивает порядок
+// с сортировкой тех же данных в памяти: равные значения ключей упорядочиваются по id
+func TestGetItemsKeysetOrder(t *testing.T) {
+	s, client := newTestService(t)
+	ctx := context.Background()
+
+	seed := []struct {
+		title   string
+		rating  float64
+		reviews int
+	}{
+		{"Dune", 4.5, 10},
+		{"Hyperion", 4.5, 3},
+		{"Solaris", 3.9, 10},
+		{"Neuromancer", 4.1, 0},
+		{"Foundation", 4.5, 10},
+		{"Ubik", 3.9, 7},
+		{"Anathem", 0, 0},
+	}
+	type row struct {
+		id      string
+		title   string
+		rating  float64
+		reviews int
+	}
+	rows := make([]row, len(seed))
+	for i, sd := range seed {
+		itm, err := client.Item.Create().
+			SetTitle(sd.title).
+			SetRating(sd.rating).
+			SetReviewCount(sd.reviews).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("create item: %v", err)
+		}
+		rows[i] = row{id: itm.ID, title: sd.title, rating: sd.rating, reviews: sd.reviews}
+	}
+
+	tests := []struct {
+		sortBy string
+		less   func(a, b row) bool
+	}{
+		{"title", func(a, b row) bool { return a.title < b.title }},
+		{"-title", func(a, b row) bool { return a.title > b.title }},
+		{"-rating", func(a, b row) bool { return a.rating > b.rating }},
+		{"-rating,title", func(a, b row) bool {
+			if a.rating != b.rating {
+				return a.rating > b.rating
+			}
+			return a.title < b.title
+		}},
+		{"review_count,-rating", func(a, b row) bool {
+			if a.reviews != b.reviews {
+				return a.reviews < b.reviews
+			}
+			return a.rating > b.rating
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sortBy, func(t *testing.T) {
+			expected := append([]row(nil), rows...)
+			sort.Slice(expected, func(i, j int) bool {
+				if tt.less(expected[i], expected[j]) {
+					return true
+				}
+				if tt.less(expected[j], expected[i]) {
+					return false
+				}
+				return expected[i].id < expected[j].id
+			})
+			want := make([]string, len(expected))
+			for i, r := range expected {
+				want[i] = r.id
+			}
+
+			var got []string
+			req := &proto.GetItemsRequest{SortBy: tt.sortBy, Limit: 2, SkipTotal: true}
+			for page := 0; ; page++ {
+				if page > len(rows) {
+					t.Fatal("cursor pagination does not terminate")
+				}
+				resp, err := s.GetItems(ctx, req)
+				if err != nil {
+					t.Fatalf("GetItems: %v", err)
+				}
+				for _, itm := range resp.Items {
+					got = append(got, itm.Id)
+				}
+				if resp.NextCursor == "" {
+					break
+				}
+				req.Cursor = resp.NextCursor
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("order = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestGetItemsCursorBoundToQuery(t *testing.T) {
+	s, client := newTestService(t)
+	ctx := context.Background()
+	for _, title := range []string{"a", "b", "c"} {
+		if _, err := client.Item.Create().SetTitle(title).SetTags([]string{"books"}).Save(ctx); err != nil {
+			t.Fatalf("create item: %v", err)
+		}
+	}
+
+	resp, err := s.GetItems(ctx, &proto.GetItemsRequest{SortBy: "title", Limit: 1})
+	if err != nil {
+		t.Fatalf("GetItems: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  *proto.GetItemsRequest
+	}{
+		{"other sort", &proto.GetItemsRequest{SortBy: "-title", Limit: 1, Cursor: resp.NextCursor}},
+		{"other tags", &proto.GetItemsRequest{SortBy: "title", Limit: 1, Tags: []string{"books"}, Cursor: resp.NextCursor}},
+		{"garbage", &proto.GetItemsRequest{SortBy: "title", Limit: 1, Cursor: "garbage"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.GetItems(ctx, tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("GetItems error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
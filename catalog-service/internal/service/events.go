@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/logging"
+	"google.golang.org/protobuf/encoding/protojson"
+	"time"
+)
+
+// Типы событий об изменении элементов, которые публикует outbox relay
+const (
+	EventItemCreated = "ItemCreated"
+	EventItemUpdated = "ItemUpdated"
+	EventItemDeleted = "ItemDeleted"
+)
+
+const itemAggregateType = "item"
+
+var eventMarshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+// enqueueItemEvent записывает событие в ленту изменений и в outbox в транзакции tx,
+// чтобы оно было опубликовано тогда и только тогда, когда изменение элемента зафиксировано
+func enqueueItemEvent(ctx context.Context, tx *ent.Tx, eventType string, itm *ent.Item) error {
+	event := &proto.ItemEvent{
+		Id:         uuid.New().String(),
+		Type:       eventType,
+		ItemId:     itm.ID,
+		Item:       toProtoItem(itm),
+		OccurredAt: time.Now().Format(time.RFC3339Nano),
+		RequestId:  logging.RequestIDFromContext(ctx),
+	}
+
+	// Номер в ленте изменений выдаёт база, поэтому запись в ленту создаётся первой,
+	// а в payload для брокера sequence уже известен
+	payload, err := eventMarshalOptions.Marshal(event)
+	if err != nil {
+		return err
+	}
+	change, err := tx.ItemChange.Create().
+		SetEventID(event.Id).
+		SetEventType(eventType).
+		SetItemID(itm.ID).
+		SetTags(itm.Tags).
+		SetPayload(payload).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	event.Sequence = change.ID
+	payload, err = eventMarshalOptions.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return tx.OutboxEvent.Create().
+		SetID(event.Id).
+		SetAggregateType(itemAggregateType).
+		SetAggregateID(itm.ID).
+		SetEventType(eventType).
+		SetPayload(payload).
+		Exec(ctx)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"bufio"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/dataio"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// exportBatchSize - сколько элементов читается из базы за один запрос
+	exportBatchSize = 500
+	// exportChunkSize - размер части файла в одном сообщении потока
+	exportChunkSize = 64 << 10
+)
+
+// ExportItems выгружает элементы в файл и отправляет его частями по мере чтения из базы.
+// Элементы читаются страницами по id, поэтому выгрузка не держит в памяти весь каталог.
+func (s *CatalogService) ExportItems(req *proto.ExportItemsRequest, stream proto.CatalogService_ExportItemsServer) error {
+	ctx := stream.Context()
+	if err := validateExportItems(req); err != nil {
+		return err
+	}
+	format, _ := dataFormat(req.Format)
+
+	columns := make([]itemColumn, 0, len(itemColumns))
+	fileColumns := make([]dataio.Column, 0, len(itemColumns))
+	if len(req.Columns) == 0 {
+		for _, column := range itemColumns {
+			columns = append(columns, column)
+			fileColumns = append(fileColumns, dataio.Column{Name: column.field, Kind: column.kind})
+		}
+	}
+	for _, mapping := range req.Columns {
+		column, _ := findItemColumn(mapping.Field)
+		columns = append(columns, column)
+		fileColumns = append(fileColumns, dataio.Column{Name: columnName(mapping), Kind: column.kind})
+	}
+
+	tags, err := s.canonicalTags(ctx, normalizeTags(req.Tags))
+	if err != nil {
+		return s.exportError(stream, err)
+	}
+	query := s.client.Item.Query()
+	if len(tags) > 0 {
+		pred, ok := tagsPredicate(req.TagMode, tags)
+		if !ok {
+			return status.Error(codes.InvalidArgument, "unknown tag match mode")
+		}
+		query = query.Where(pred)
+	}
+
+	writer := bufio.NewWriterSize(exportStreamWriter{stream: stream}, exportChunkSize)
+	encoder, err := dataio.NewEncoder(writer, format, fileColumns, dataio.Options{ListSeparator: req.ListSeparator})
+	if err != nil {
+		return s.exportError(stream, err)
+	}
+
+	var lastID string
+	values := make([]any, len(columns))
+	for {
+		page := query.Clone().Order(item.ByID()).Limit(exportBatchSize)
+		if lastID != "" {
+			page = page.Where(item.IDGT(lastID))
+		}
+		items, err := page.All(ctx)
+		if err != nil {
+			return s.exportError(stream, err)
+		}
+
+		for _, itm := range items {
+			for i, column := range columns {
+				values[i] = column.value(itm)
+			}
+			if err := encoder.Encode(values); err != nil {
+				return s.exportError(stream, err)
+			}
+		}
+		if len(items) < exportBatchSize {
+			break
+		}
+		lastID = items[len(items)-1].ID
+	}
+
+	if err := encoder.Close(); err != nil {
+		return s.exportError(stream, err)
+	}
+	if err := writer.Flush(); err != nil {
+		return s.exportError(stream, err)
+	}
+	return nil
+}
+
+// exportStreamWriter отправляет каждую запись в поток отдельным сообщением.
+// Размер сообщений задаёт bufio.Writer поверх него.
+type exportStreamWriter struct {
+	stream proto.CatalogService_ExportItemsServer
+}
+
+func (w exportStreamWriter) Write(p []byte) (int, error) {
+	// Send может держать ссылку на буфер до отправки, а bufio.Writer переиспользует его
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+	if err := w.stream.Send(&proto.ExportItemsResponse{Chunk: chunk}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *CatalogService) exportError(stream proto.CatalogService_ExportItemsServer, err error) error {
+	ctx := stream.Context()
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	s.log(ctx).Error("Failed to export items", zap.Error(err))
+	return status.Error(codes.Internal, "failed to export items")
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+	"strings"
+)
+
+// normalizeTags убирает пробелы, пустые значения и дубликаты
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	return result
+}
+
+func hasTag(tag string) predicate.Item {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(item.FieldTags), tag))
+	}
+}
+
+func hasAllTags(tags []string) predicate.Item {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(item.FieldTags), tags))
+	}
+}
+
+// tagsPredicate строит условие фильтрации по тегам.
+// Все варианты сводятся к оператору @>, который обслуживается GIN индексом.
+func tagsPredicate(mode proto.TagMatchMode, tags []string) (predicate.Item, bool) {
+	switch mode {
+	case proto.TagMatchMode_TAG_MATCH_MODE_ANY:
+		preds := make([]predicate.Item, len(tags))
+		for i, tag := range tags {
+			preds[i] = hasTag(tag)
+		}
+		return item.Or(preds...), true
+	case proto.TagMatchMode_TAG_MATCH_MODE_ALL:
+		return hasAllTags(tags), true
+	case proto.TagMatchMode_TAG_MATCH_MODE_NONE:
+		preds := make([]predicate.Item, len(tags))
+		for i, tag := range tags {
+			preds[i] = hasTag(tag)
+		}
+		return item.Or(item.TagsIsNil(), item.Not(item.Or(preds...))), true
+	default:
+		return nil, false
+	}
+}
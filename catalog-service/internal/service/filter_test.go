@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/testdb"
+	"go.uber.org/zap"
+	"reflect"
+	"testing"
+)
+
+// TestGetItemsTagModes проверяет фильтр по тегам на PostgreSQL: оператор @> над jsonb в SQLite не выполнить
+func TestGetItemsTagModes(t *testing.T) {
+	client := testdb.Open(t)
+	s := NewCatalogService(client, zap.NewNop(), []byte("test-secret"), nil)
+	ctx := context.Background()
+
+	seed := map[string][]string{
+		"a": {"books", "sale"},
+		"b": {"books"},
+		"c": {"films"},
+		"d": nil,
+	}
+	for title, tags := range seed {
+		if _, err := client.Item.Create().SetTitle(title).SetTags(tags).Save(ctx); err != nil {
+			t.Fatalf("create item: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		mode proto.TagMatchMode
+		tags []string
+		want []string
+	}{
+		{"any of one tag", proto.TagMatchMode_TAG_MATCH_MODE_ANY, []string{"books"}, []string{"a", "b"}},
+		{"any of several tags", proto.TagMatchMode_TAG_MATCH_MODE_ANY, []string{"sale", "films"}, []string{"a", "c"}},
+		{"all tags", proto.TagMatchMode_TAG_MATCH_MODE_ALL, []string{"books", "sale"}, []string{"a"}},
+		{"all tags, no match", proto.TagMatchMode_TAG_MATCH_MODE_ALL, []string{"books", "films"}, nil},
+		{"none of one tag keeps untagged items", proto.TagMatchMode_TAG_MATCH_MODE_NONE, []string{"books"}, []string{"c", "d"}},
+		{"none of several tags", proto.TagMatchMode_TAG_MATCH_MODE_NONE, []string{"sale", "films"}, []string{"b", "d"}},
+		{"duplicates ignored", proto.TagMatchMode_TAG_MATCH_MODE_ALL, []string{" books ", "books"}, []string{"a", "b"}},
+		{"no tags, no filter", proto.TagMatchMode_TAG_MATCH_MODE_NONE, nil, []string{"a", "b", "c", "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.GetItems(ctx, &proto.GetItemsRequest{Tags: tt.tags, TagMode: tt.mode, SortBy: "title"})
+			if err != nil {
+				t.Fatalf("GetItems: %v", err)
+			}
+			var got []string
+			for _, itm := range resp.Items {
+				got = append(got, itm.Title)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("titles = %v, want %v", got, tt.want)
+			}
+			if int(resp.Total) != len(tt.want) {
+				t.Errorf("total = %d, want %d", resp.Total, len(tt.want))
+			}
+		})
+	}
+}
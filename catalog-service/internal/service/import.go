@@ -0,0 +1,399 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/schema"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/dataio"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/validation"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"io"
+	"slices"
+	"strings"
+)
+
+const (
+	// importBatchSize - сколько строк файла сохраняется за один проход
+	importBatchSize = 500
+	// maxImportErrors ограничивает отчёт об ошибках: для битого файла на миллион строк он бесполезен целиком
+	maxImportErrors = 100
+)
+
+// errImportNotCommitted откатывает транзакцию импорта в режиме dry run и при ошибках в атомарном режиме
+var errImportNotCommitted = errors.New("import not committed")
+
+// ImportItems загружает элементы из файла, который клиент присылает частями.
+// Весь импорт выполняется в одной транзакции, поэтому dry run и атомарный режим
+// проверяют строки так же, как настоящий импорт, и просто откатывают её в конце.
+func (s *CatalogService) ImportItems(stream proto.CatalogService_ImportItemsServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "options are required")
+	}
+	if err != nil {
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return status.Error(codes.InvalidArgument, "first message must contain options")
+	}
+	if err := validateImportOptions(opts); err != nil {
+		return err
+	}
+	format, _ := dataFormat(opts.Format)
+
+	columns := make(map[string]string, len(importableItemFields))
+	if len(opts.Columns) == 0 {
+		for _, field := range importableItemFields {
+			columns[field] = field
+		}
+	}
+	for _, mapping := range opts.Columns {
+		columns[mapping.Field] = columnName(mapping)
+	}
+
+	source := &importStreamReader{stream: stream}
+	decoder, err := dataio.NewDecoder(source, format, dataio.Options{
+		ListSeparator: opts.ListSeparator,
+		Lists:         []string{columns["tags"]},
+	})
+	if err != nil {
+		return importReadError(source, err)
+	}
+	defer decoder.Close()
+
+	imp := &itemImport{
+		opts:    opts,
+		columns: columns,
+		resp:    &proto.ImportItemsResponse{DryRun: opts.DryRun},
+	}
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		for {
+			record, err := decoder.Decode()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			var recordErr *dataio.RecordError
+			if errors.As(err, &recordErr) {
+				imp.resp.Rows++
+				imp.fail(recordErr.Row, "", status.Error(codes.InvalidArgument, recordErr.Err.Error()))
+				continue
+			}
+			if err != nil {
+				return importReadError(source, err)
+			}
+
+			imp.resp.Rows++
+			row, err := imp.parse(record)
+			if err != nil {
+				imp.fail(record.Row, row.externalID, err)
+				continue
+			}
+			// Вторая строка с тем же ключом должна увидеть элемент, созданный первой
+			if row.externalID != "" && imp.pendingKeys[row.externalID] {
+				if err := imp.flush(ctx, tx); err != nil {
+					return err
+				}
+			}
+			imp.add(row)
+			if len(imp.pending) >= importBatchSize {
+				if err := imp.flush(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err := imp.flush(ctx, tx); err != nil {
+			return err
+		}
+
+		if opts.DryRun || (opts.Mode == proto.BatchMode_BATCH_MODE_ATOMIC && imp.resp.Failed > 0) {
+			return errImportNotCommitted
+		}
+		return nil
+	})
+	switch {
+	case err == nil:
+		imp.resp.Committed = true
+	case errors.Is(err, errImportNotCommitted):
+	default:
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		if ent.IsConstraintError(err) {
+			return status.Error(codes.Aborted, "import conflicted with a concurrent change, retry it")
+		}
+		s.log(ctx).Error("Failed to import items", zap.Error(err))
+		return status.Error(codes.Internal, "failed to import items")
+	}
+
+	// Ошибки разбора попадают в отчёт сразу, а ошибки проверки - при сохранении пачки
+	slices.SortFunc(imp.resp.Errors, func(a, b *proto.ImportRowError) int {
+		return cmp.Compare(a.Row, b.Row)
+	})
+
+	s.log(ctx).Info("Items imported",
+		zap.Stringer("format", format),
+		zap.Bool("committed", imp.resp.Committed),
+		zap.Int32("rows", imp.resp.Rows),
+		zap.Int32("created", imp.resp.Created),
+		zap.Int32("updated", imp.resp.Updated),
+		zap.Int32("failed", imp.resp.Failed),
+	)
+	return stream.SendAndClose(imp.resp)
+}
+
+// importStreamReader склеивает части файла из потока в io.Reader
+type importStreamReader struct {
+	stream proto.CatalogService_ImportItemsServer
+	buf    []byte
+	// err - ошибка самого потока, в отличие от ошибок разбора файла
+	err error
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		chunk, ok := req.Payload.(*proto.ImportItemsRequest_Chunk)
+		if !ok {
+			r.err = status.Error(codes.InvalidArgument, "only the first message may contain options")
+			return 0, r.err
+		}
+		r.buf = chunk.Chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// importReadError отличает обрыв потока от файла, который не удалось разобрать
+func importReadError(source *importStreamReader, err error) error {
+	if source.err != nil {
+		if _, ok := status.FromError(source.err); ok {
+			return source.err
+		}
+		return status.FromContextError(source.err).Err()
+	}
+	return status.Errorf(codes.InvalidArgument, "invalid file: %v", err)
+}
+
+// importRow - строка файла, приведённая к полям элемента
+type importRow struct {
+	row        int
+	externalID string
+	// paths - поля, которые есть в строке; при обновлении меняются только они
+	paths       []string
+	title       string
+	description string
+	tags        []string
+	imageURL    string
+}
+
+type itemImport struct {
+	opts    *proto.ImportOptions
+	columns map[string]string
+	resp    *proto.ImportItemsResponse
+
+	pending     []*importRow
+	pendingKeys map[string]bool
+}
+
+// parse переносит значения колонок в поля элемента. Поле с null очищается, как в PATCH.
+func (imp *itemImport) parse(record *dataio.Record) (*importRow, error) {
+	row := &importRow{row: record.Row}
+	var v validation.Errors
+	for _, field := range importableItemFields {
+		column, ok := imp.columns[field]
+		if !ok {
+			continue
+		}
+		values, ok := record.Values[column]
+		if !ok {
+			continue
+		}
+		if field == "tags" {
+			row.tags = values
+			row.paths = append(row.paths, field)
+			continue
+		}
+		if len(values) > 1 {
+			v.Add(field, "must be a single value, got a list")
+			continue
+		}
+
+		var value string
+		if len(values) == 1 {
+			value = values[0]
+		}
+		switch field {
+		case "external_id":
+			row.externalID = strings.TrimSpace(value)
+			v.MaxLength(field, row.externalID, maxExternalIDLength)
+			continue
+		case "title":
+			row.title = value
+		case "description":
+			row.description = value
+		case "image_url":
+			row.imageURL = value
+		}
+		row.paths = append(row.paths, field)
+	}
+	return row, v.Err()
+}
+
+func (imp *itemImport) add(row *importRow) {
+	if imp.pendingKeys == nil {
+		imp.pendingKeys = make(map[string]bool, importBatchSize)
+	}
+	imp.pending = append(imp.pending, row)
+	if row.externalID != "" {
+		imp.pendingKeys[row.externalID] = true
+	}
+}
+
+func (imp *itemImport) fail(row int, externalID string, err error) {
+	imp.resp.Failed++
+	if len(imp.resp.Errors) >= maxImportErrors {
+		imp.resp.ErrorsTruncated = true
+		return
+	}
+	result := batchError(0, externalID, err)
+	imp.resp.Errors = append(imp.resp.Errors, &proto.ImportRowError{
+		Row:        int32(row),
+		ExternalId: externalID,
+		Code:       result.Code,
+		Message:    result.Message,
+		Violations: result.Violations,
+		Reason:     result.Reason,
+	})
+}
+
+// flush сохраняет накопленные строки: строки с ключом существующего элемента обновляют его,
+// остальные создаются одним INSERT
+func (imp *itemImport) flush(ctx context.Context, tx *ent.Tx) error {
+	rows := imp.pending
+	imp.pending = imp.pending[:0]
+	clear(imp.pendingKeys)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.externalID != "" {
+			keys = append(keys, row.externalID)
+		}
+	}
+	existing := make(map[string]*ent.Item, len(keys))
+	if len(keys) > 0 {
+		// Удалённые элементы тоже держат свой external_id
+		items, err := tx.Item.Query().
+			Where(item.ExternalIDIn(keys...)).
+			All(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return err
+		}
+		for _, itm := range items {
+			existing[*itm.ExternalID] = itm
+		}
+	}
+
+	var creates []*importRow
+	for _, row := range rows {
+		itm, ok := existing[row.externalID]
+		if !ok {
+			req := row.createRequest()
+			if err := validateCreateItem(req); err != nil {
+				imp.fail(row.row, row.externalID, err)
+				continue
+			}
+			creates = append(creates, row)
+			continue
+		}
+
+		switch {
+		case !imp.opts.Upsert:
+			imp.fail(row.row, row.externalID, status.Errorf(codes.AlreadyExists, "item with external_id %q already exists", row.externalID))
+		case itm.DeletedAt != nil:
+			// Без причины VERSION_MISMATCH шлюз отдаёт FailedPrecondition как 400: повтор не поможет, нужно восстановить элемент
+			imp.fail(row.row, row.externalID, status.Errorf(codes.FailedPrecondition, "item with external_id %q is deleted, restore it first", row.externalID))
+		default:
+			if err := imp.update(ctx, tx, itm, row); err != nil {
+				return err
+			}
+		}
+	}
+	if len(creates) == 0 {
+		return nil
+	}
+
+	items, err := tx.Item.MapCreateBulk(creates, func(c *ent.ItemCreate, i int) {
+		req := creates[i].createRequest()
+		c.SetTitle(strings.TrimSpace(req.Title)).
+			SetDescription(req.Description).
+			SetTags(normalizeTags(req.Tags)).
+			SetImageURL(req.ImageUrl)
+		if key := creates[i].externalID; key != "" {
+			c.SetExternalID(key)
+		}
+	}).Save(ctx)
+	if err != nil {
+		return err
+	}
+	for _, itm := range items {
+		if err := enqueueItemEvent(ctx, tx, EventItemCreated, itm); err != nil {
+			return err
+		}
+	}
+	imp.resp.Created += int32(len(items))
+	return nil
+}
+
+func (imp *itemImport) update(ctx context.Context, tx *ent.Tx, itm *ent.Item, row *importRow) error {
+	req := row.updateRequest(itm.ID)
+	if err := validateUpdateItem(req, row.paths); err != nil {
+		imp.fail(row.row, row.externalID, err)
+		return nil
+	}
+	if _, err := updateItemTx(ctx, tx, req, row.paths); err != nil {
+		return err
+	}
+	imp.resp.Updated++
+	return nil
+}
+
+func (row *importRow) createRequest() *proto.CreateItemRequest {
+	return &proto.CreateItemRequest{
+		Title:       row.title,
+		Description: row.description,
+		Tags:        row.tags,
+		ImageUrl:    row.imageURL,
+	}
+}
+
+func (row *importRow) updateRequest(id string) *proto.UpdateItemRequest {
+	return &proto.UpdateItemRequest{
+		Id:          id,
+		Title:       &row.title,
+		Description: &row.description,
+		Tags:        row.tags,
+		ImageUrl:    &row.imageURL,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: row.paths},
+	}
+}
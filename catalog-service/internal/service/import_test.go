@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// fakeImportStream отдаёт опции и файл одним куском и запоминает ответ
+type fakeImportStream struct {
+	grpc.ServerStream
+
+	requests []*proto.ImportItemsRequest
+	resp     *proto.ImportItemsResponse
+}
+
+func (f *fakeImportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeImportStream) Recv() (*proto.ImportItemsRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+	return req, nil
+}
+
+func (f *fakeImportStream) SendAndClose(resp *proto.ImportItemsResponse) error {
+	f.resp = resp
+	return nil
+}
+
+func TestImportItemsExistingKeys(t *testing.T) {
+	s, client := newTestService(t)
+	ctx := context.Background()
+	client.Item.Create().SetTitle("live").SetExternalID("live").SaveX(ctx)
+	deleted := client.Item.Create().SetTitle("deleted").SetExternalID("deleted").SaveX(ctx)
+	if _, err := s.DeleteItem(ctx, &proto.DeleteItemRequest{Id: deleted.ID}); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		upsert      bool
+		wantUpdated int32
+		wantCodes   []codes.Code
+	}{
+		{"without upsert", false, 0, []codes.Code{codes.AlreadyExists, codes.AlreadyExists}},
+		{"upsert", true, 1, []codes.Code{codes.FailedPrecondition}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &fakeImportStream{requests: []*proto.ImportItemsRequest{
+				{Payload: &proto.ImportItemsRequest_Options{Options: &proto.ImportOptions{
+					Format: proto.DataFormat_DATA_FORMAT_CSV,
+					Upsert: tt.upsert,
+					DryRun: true,
+				}}},
+				{Payload: &proto.ImportItemsRequest_Chunk{Chunk: []byte("external_id,title\nlive,updated\ndeleted,updated\nnew,created\n")}},
+			}}
+			if err := s.ImportItems(stream); err != nil {
+				t.Fatalf("ImportItems: %v", err)
+			}
+
+			resp := stream.resp
+			if resp.Created != 1 || resp.Updated != tt.wantUpdated {
+				t.Errorf("created %d and updated %d, want 1 and %d", resp.Created, resp.Updated, tt.wantUpdated)
+			}
+			got := make([]codes.Code, len(resp.Errors))
+			for i, e := range resp.Errors {
+				got[i] = codes.Code(e.Code)
+				// Удалённый элемент - не конфликт версий, шлюз должен ответить 400, а не 412
+				if e.Reason != "" {
+					t.Errorf("row %d reason = %q, want empty", e.Row, e.Reason)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.wantCodes) {
+				t.Errorf("error codes = %v, want %v", got, tt.wantCodes)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"strings"
+)
+
+// updatableItemFields - поля Item, которые можно указать в update_mask, в порядке применения
+var updatableItemFields = []string{"title", "description", "tags", "image_url"}
+
+func isUpdatableItemField(path string) bool {
+	for _, field := range updatableItemFields {
+		if field == path {
+			return true
+		}
+	}
+	return false
+}
+
+// itemUpdatePaths возвращает поля, которые нужно обновить.
+// Без update_mask сохраняется прежнее поведение: обновляются только переданные поля,
+// а пустой список тегов считается непереданным.
+func itemUpdatePaths(req *proto.UpdateItemRequest) []string {
+	if req.UpdateMask == nil {
+		var paths []string
+		if req.Title != nil {
+			paths = append(paths, "title")
+		}
+		if req.Description != nil {
+			paths = append(paths, "description")
+		}
+		if len(req.Tags) > 0 {
+			paths = append(paths, "tags")
+		}
+		if req.ImageUrl != nil {
+			paths = append(paths, "image_url")
+		}
+		return paths
+	}
+
+	for _, path := range req.UpdateMask.Paths {
+		if path == "*" {
+			return updatableItemFields
+		}
+	}
+	return req.UpdateMask.Paths
+}
+
+// applyItemUpdate переносит поля из paths в builder. Поле без значения очищается.
+// paths должны быть проверены validateUpdateItem.
+func applyItemUpdate(builder *ent.ItemUpdateOne, req *proto.UpdateItemRequest, paths []string) {
+	for _, path := range paths {
+		switch path {
+		case "title":
+			builder.SetTitle(strings.TrimSpace(req.GetTitle()))
+		case "description":
+			builder.SetDescription(req.GetDescription())
+		case "tags":
+			if tags := normalizeTags(req.Tags); len(tags) > 0 {
+				builder.SetTags(tags)
+			} else {
+				builder.ClearTags()
+			}
+		case "image_url":
+			builder.SetImageURL(req.GetImageUrl())
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"reflect"
+	"testing"
+)
+
+func TestItemUpdatePaths(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *proto.UpdateItemRequest
+		want []string
+	}{
+		{
+			name: "no mask, only set fields",
+			req:  &proto.UpdateItemRequest{Title: stringPtr("t"), ImageUrl: stringPtr("")},
+			want: []string{"title", "image_url"},
+		},
+		{
+			name: "no mask, empty tags are not set",
+			req:  &proto.UpdateItemRequest{Description: stringPtr("d"), Tags: []string{}},
+			want: []string{"description"},
+		},
+		{
+			name: "no mask, nothing set",
+			req:  &proto.UpdateItemRequest{},
+			want: nil,
+		},
+		{
+			name: "mask is taken as is",
+			req:  &proto.UpdateItemRequest{Title: stringPtr("t"), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}}},
+			want: []string{"tags"},
+		},
+		{
+			name: "empty mask updates nothing",
+			req:  &proto.UpdateItemRequest{Title: stringPtr("t"), UpdateMask: &fieldmaskpb.FieldMask{}},
+			want: nil,
+		},
+		{
+			name: "wildcard",
+			req:  &proto.UpdateItemRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "*"}}},
+			want: updatableItemFields,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := itemUpdatePaths(tt.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("itemUpdatePaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateItemMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *proto.UpdateItemRequest
+		want    *proto.Item
+		wantErr codes.Code
+	}{
+		{
+			name: "only masked fields change",
+			req: &proto.UpdateItemRequest{
+				Title:       stringPtr("New title"),
+				Description: stringPtr("ignored"),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+			},
+			want: &proto.Item{Title: "New title", Description: "Old description", Tags: []string{"old"}, ImageUrl: "https://example.com/old.png", Version: 2},
+		},
+		{
+			name: "masked field without value is cleared",
+			req: &proto.UpdateItemRequest{
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "tags", "image_url"}},
+			},
+			want: &proto.Item{Title: "Old title", Version: 2},
+		},
+		{
+			name: "tags are replaced and trimmed",
+			req: &proto.UpdateItemRequest{
+				Tags:       []string{" new ", "other"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+			},
+			want: &proto.Item{Title: "Old title", Description: "Old description", Tags: []string{"new", "other"}, ImageUrl: "https://example.com/old.png", Version: 2},
+		},
+		{
+			name: "no mask keeps fields that are not set",
+			req:  &proto.UpdateItemRequest{Description: stringPtr("")},
+			want: &proto.Item{Title: "Old title", Tags: []string{"old"}, ImageUrl: "https://example.com/old.png", Version: 2},
+		},
+		{
+			name: "empty mask changes nothing",
+			req:  &proto.UpdateItemRequest{Title: stringPtr("ignored"), UpdateMask: &fieldmaskpb.FieldMask{}},
+			want: &proto.Item{Title: "Old title", Description: "Old description", Tags: []string{"old"}, ImageUrl: "https://example.com/old.png", Version: 1},
+		},
+		{
+			name:    "title cannot be cleared",
+			req:     &proto.UpdateItemRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name:    "unknown field",
+			req:     &proto.UpdateItemRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"rating"}}},
+			wantErr: codes.InvalidArgument,
+		},
+		{
+			name:    "stale version",
+			req:     &proto.UpdateItemRequest{Title: stringPtr("t"), ExpectedVersion: new(int64)},
+			wantErr: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, client := newTestService(t)
+			ctx := context.Background()
+			itm, err := client.Item.Create().
+				SetTitle("Old title").
+				SetDescription("Old description").
+				SetTags([]string{"old"}).
+				SetImageURL("https://example.com/old.png").
+				Save(ctx)
+			if err != nil {
+				t.Fatalf("create item: %v", err)
+			}
+
+			tt.req.Id = itm.ID
+			got, err := s.UpdateItem(ctx, tt.req)
+			if tt.wantErr != codes.OK {
+				if status.Code(err) != tt.wantErr {
+					t.Fatalf("UpdateItem error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateItem: %v", err)
+			}
+
+			if got.Title != tt.want.Title || got.Description != tt.want.Description ||
+				got.ImageUrl != tt.want.ImageUrl || got.Version != tt.want.Version ||
+				len(got.Tags)+len(tt.want.Tags) > 0 && !reflect.DeepEqual(got.Tags, tt.want.Tags) {
+				t.Errorf("UpdateItem() = {title: %q, description: %q, tags: %v, image_url: %q, version: %d}, want %v",
+					got.Title, got.Description, got.Tags, got.ImageUrl, got.Version, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/review"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+func (s *CatalogService) CreateReview(ctx context.Context, req *proto.CreateReviewRequest) (*proto.Review, error) {
+	if err := validateCreateReview(req); err != nil {
+		return nil, err
+	}
+
+	var rvw *ent.Review
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := lockItem(ctx, tx, req.ItemId); err != nil {
+			return err
+		}
+
+		var err error
+		rvw, err = tx.Review.Create().
+			SetItemID(req.ItemId).
+			SetAuthor(req.Author).
+			SetScore(int(req.Score)).
+			SetText(req.Text).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		return recomputeRating(ctx, tx, req.ItemId)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "item not found")
+		}
+		s.log(ctx).Error("Failed to create review", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to create review")
+	}
+
+	return toProtoReview(rvw), nil
+}
+
+func (s *CatalogService) ListReviews(ctx context.Context, req *proto.ListReviewsRequest) (*proto.ListReviewsResponse, error) {
+	if err := validateListReviews(req); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.client.Item.Query().Where(item.ID(req.ItemId)).Exist(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to check item", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list reviews")
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+
+	query := s.client.Review.Query().Where(review.ItemID(req.ItemId))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to count reviews", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to count reviews")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+
+	reviews, err := query.
+		Limit(limit).
+		Offset((page-1)*limit).
+		Order(ent.Desc(review.FieldCreatedAt), ent.Asc(review.FieldID)).
+		All(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to fetch reviews", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fetch reviews")
+	}
+
+	protoReviews := make([]*proto.Review, len(reviews))
+	for i, rvw := range reviews {
+		protoReviews[i] = toProtoReview(rvw)
+	}
+
+	return &proto.ListReviewsResponse{
+		Reviews:    protoReviews,
+		Total:      int32(total),
+		Page:       int32(page),
+		TotalPages: int32((total + limit - 1) / limit),
+	}, nil
+}
+
+var errReviewNotFound = errors.New("review not found")
+
+func (s *CatalogService) DeleteReview(ctx context.Context, req *proto.DeleteReviewRequest) (*proto.DeleteReviewResponse, error) {
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		if err := lockItem(ctx, tx, req.ItemId); err != nil {
+			return err
+		}
+
+		deleted, err := tx.Review.Delete().
+			Where(review.ID(req.Id), review.ItemID(req.ItemId)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return errReviewNotFound
+		}
+
+		return recomputeRating(ctx, tx, req.ItemId)
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, errReviewNotFound):
+		return nil, status.Error(codes.NotFound, "review not found")
+	case ent.IsNotFound(err):
+		return nil, status.Error(codes.NotFound, "item not found")
+	default:
+		s.log(ctx).Error("Failed to delete review", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to delete review")
+	}
+
+	return &proto.DeleteReviewResponse{
+		Success: true,
+	}, nil
+}
+
+// lockItem блокирует строку элемента до конца транзакции, чтобы параллельные
+// изменения отзывов не пересчитывали рейтинг по устаревшим данным
+func lockItem(ctx context.Context, tx *ent.Tx, id string) error {
+	_, err := tx.Item.Query().
+		Where(item.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	return err
+}
+
+// recomputeRating пересчитывает rating и review_count элемента по его отзывам.
+// Рейтинг влияет на рекомендации, поэтому изменение публикуется как ItemUpdated.
+func recomputeRating(ctx context.Context, tx *ent.Tx, itemID string) error {
+	query := tx.Review.Query().Where(review.ItemID(itemID))
+
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	var rating float64
+	if count > 0 {
+		rating, err = query.Aggregate(ent.Mean(review.FieldScore)).Float64(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	itm, err := tx.Item.UpdateOneID(itemID).
+		SetRating(rating).
+		SetReviewCount(count).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	return enqueueItemEvent(ctx, tx, EventItemUpdated, itm)
+}
+
+func toProtoReview(rvw *ent.Review) *proto.Review {
+	return &proto.Review{
+		Id:        rvw.ID,
+		ItemId:    rvw.ItemID,
+		Author:    rvw.Author,
+		Score:     int32(rvw.Score),
+		Text:      rvw.Text,
+		CreatedAt: rvw.CreatedAt.Format(time.RFC3339),
+	}
+}
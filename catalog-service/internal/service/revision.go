@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemrevision"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/schema"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/audit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// ListItemRevisions возвращает историю изменений элемента, сначала последние.
+// История доступна и для удалённых элементов, в том числе после очистки корзины.
+func (s *CatalogService) ListItemRevisions(ctx context.Context, req *proto.ListItemRevisionsRequest) (*proto.ListItemRevisionsResponse, error) {
+	if err := validateListItemRevisions(req); err != nil {
+		return nil, err
+	}
+
+	query := s.client.ItemRevision.Query().Where(itemrevision.ItemID(req.ItemId))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to count item revisions", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to count item revisions")
+	}
+	if total == 0 {
+		// Элементы, созданные до появления истории, существуют без ревизий
+		exists, err := s.client.Item.Query().Where(item.ID(req.ItemId)).Exist(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			s.log(ctx).Error("Failed to check item", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list item revisions")
+		}
+		if !exists {
+			return nil, status.Error(codes.NotFound, "item not found")
+		}
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+
+	revisions, err := query.
+		Order(ent.Desc(itemrevision.FieldCreatedAt), ent.Desc(itemrevision.FieldID)).
+		Limit(limit).
+		Offset((page - 1) * limit).
+		All(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to fetch item revisions", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fetch item revisions")
+	}
+
+	protoRevisions := make([]*proto.ItemRevision, len(revisions))
+	for i, rev := range revisions {
+		protoRevisions[i] = toProtoItemRevision(rev)
+	}
+
+	return &proto.ListItemRevisionsResponse{
+		Revisions:  protoRevisions,
+		Total:      int32(total),
+		Page:       int32(page),
+		TotalPages: int32((total + limit - 1) / limit),
+	}, nil
+}
+
+// RevertItem возвращает редактируемым полям элемента значения из ревизии.
+// Откат - обычное изменение: версия увеличивается, в истории появляется новая ревизия.
+func (s *CatalogService) RevertItem(ctx context.Context, req *proto.RevertItemRequest) (*proto.Item, error) {
+	if err := validateRevertItem(req); err != nil {
+		return nil, err
+	}
+
+	rev, err := s.client.ItemRevision.Query().
+		Where(itemrevision.ID(req.RevisionId), itemrevision.ItemID(req.ItemId)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "revision not found")
+		}
+		s.log(ctx).Error("Failed to get item revision", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to revert item")
+	}
+
+	var itm *ent.Item
+	err = withTx(ctx, s.client, func(tx *ent.Tx) error {
+		builder := tx.Item.UpdateOneID(req.ItemId).
+			AddVersion(1).
+			SetTitle(rev.Snapshot.Title).
+			SetDescription(rev.Snapshot.Description).
+			SetImageURL(rev.Snapshot.ImageURL)
+		if len(rev.Snapshot.Tags) > 0 {
+			builder.SetTags(rev.Snapshot.Tags)
+		} else {
+			builder.ClearTags()
+		}
+		if req.ExpectedVersion != nil {
+			builder.Where(item.Version(*req.ExpectedVersion))
+		}
+
+		var err error
+		itm, err = builder.Save(audit.WithRevertedFrom(ctx, rev.ID))
+		if err != nil {
+			return err
+		}
+		return enqueueItemEvent(ctx, tx, EventItemUpdated, itm)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, s.missingItemError(ctx, s.client, req.ItemId, req.ExpectedVersion)
+		}
+		if ent.IsValidationError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.log(ctx).Error("Failed to revert item", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to revert item")
+	}
+
+	return toProtoItem(itm), nil
+}
+
+func toProtoItemRevision(rev *ent.ItemRevision) *proto.ItemRevision {
+	snapshot := rev.Snapshot
+	changes := make([]*proto.FieldChange, 0, len(rev.Changes))
+	// Порядок полей фиксирован, а не случайный, как при обходе map
+	for _, field := range audit.TrackedFields {
+		change, ok := rev.Changes[field]
+		if !ok {
+			continue
+		}
+		changes = append(changes, &proto.FieldChange{
+			Field:    field,
+			OldValue: marshalChangeValue(change.Old),
+			NewValue: marshalChangeValue(change.New),
+		})
+	}
+
+	return &proto.ItemRevision{
+		Id:          rev.ID,
+		ItemId:      rev.ItemID,
+		Operation:   rev.Operation.String(),
+		ItemVersion: rev.ItemVersion,
+		Snapshot: &proto.Item{
+			Id:          rev.ItemID,
+			Title:       snapshot.Title,
+			Description: snapshot.Description,
+			Tags:        snapshot.Tags,
+			ImageUrl:    snapshot.ImageURL,
+			Rating:      snapshot.Rating,
+			ReviewCount: int32(snapshot.ReviewCount),
+			CreatedAt:   snapshot.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   snapshot.UpdatedAt.Format(time.RFC3339),
+			Version:     snapshot.Version,
+			DeletedAt:   formatOptionalTime(snapshot.DeletedAt),
+		},
+		Changes:      changes,
+		Actor:        rev.Actor,
+		RequestId:    rev.RequestID,
+		RevertedFrom: rev.RevertedFrom,
+		CreatedAt:    rev.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func marshalChangeValue(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "null"
+	}
+	return string(data)
+}
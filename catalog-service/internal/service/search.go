@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"html"
+	"strings"
+	"unicode"
+)
+
+// searchVector - выражение tsvector, по которому строится индекс item_search.
+// Запросы должны использовать его без изменений, иначе Postgres не применит индекс.
+// Конфигурация simple не делает стемминга, поэтому одинаково работает для любого языка.
+const searchVector = `setweight(to_tsvector('simple', coalesce(title, '')), 'A') || ` +
+	`setweight(to_tsvector('simple', coalesce(description, '')), 'B') || ` +
+	`setweight(jsonb_to_tsvector('simple', coalesce(tags, '[]'::jsonb), '["string"]'), 'C')`
+
+// ts_headline не экранирует текст, поэтому совпадения сначала отмечаются управляющими символами,
+// а на <mark> они заменяются уже после экранирования: разметка из данных не дойдёт до клиента как HTML
+const (
+	highlightStart   = "\x02"
+	highlightStop    = "\x03"
+	highlightOptions = `StartSel="` + highlightStart + `", StopSel="` + highlightStop + `"`
+)
+
+var highlightMarks = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// highlight экранирует результат ts_headline и подставляет <mark> вместо маркеров совпадений
+func highlight(headline string) string {
+	return highlightMarks.Replace(html.EscapeString(headline))
+}
+
+// CreateSearchIndex создаёт GIN индекс для полнотекстового поиска.
+// Ent не умеет описывать индексы по выражениям, поэтому индекс создаётся отдельно после миграции схемы.
+func CreateSearchIndex(ctx context.Context, client *ent.Client) error {
+	_, err := client.ExecContext(ctx, fmt.Sprintf(
+		"CREATE INDEX IF NOT EXISTS item_search ON %s USING GIN ((%s))",
+		item.Table, searchVector,
+	))
+	return err
+}
+
+// buildTSQuery превращает пользовательский ввод в tsquery с поиском по префиксу:
+// "sci fi" -> "sci:* & fi:*". Все символы, кроме букв и цифр, отбрасываются,
+// поэтому результат безопасно передавать в to_tsquery.
+func buildTSQuery(input string) string {
+	terms := strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+type searchHit struct {
+	id          string
+	rank        float64
+	title       string
+	description string
+}
+
+func (s *CatalogService) SearchItems(ctx context.Context, req *proto.SearchItemsRequest) (*proto.SearchItemsResponse, error) {
+	if err := validateSearchItems(req); err != nil {
+		return nil, err
+	}
+
+	tsQuery := buildTSQuery(req.Query)
+	if tsQuery == "" {
+		return nil, status.Error(codes.InvalidArgument, "search query is empty")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+
+	// Сырые запросы обходят интерцептор мягкого удаления, поэтому условие на deleted_at указано явно
+	var total int
+	countRows, err := s.client.QueryContext(ctx, fmt.Sprintf(
+		"SELECT count(*) FROM %s WHERE (%s) @@ to_tsquery('simple', $1) AND %s IS NULL",
+		item.Table, searchVector, item.FieldDeletedAt,
+	), tsQuery)
+	if err != nil {
+		s.log(ctx).Error("Failed to count search results", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to search items")
+	}
+	defer countRows.Close()
+	if countRows.Next() {
+		if err := countRows.Scan(&total); err != nil {
+			s.log(ctx).Error("Failed to scan search count", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to search items")
+		}
+	}
+
+	rows, err := s.client.QueryContext(ctx, fmt.Sprintf(`SELECT id,
+		ts_rank_cd(%[2]s, q) AS rank,
+		ts_headline('simple', title, q, $4),
+		ts_headline('simple', coalesce(description, ''), q, $5)
+		FROM %[1]s, to_tsquery('simple', $1) q
+		WHERE (%[2]s) @@ q AND %[3]s IS NULL
+		ORDER BY rank DESC, id
+		LIMIT $2 OFFSET $3`,
+		item.Table, searchVector, item.FieldDeletedAt,
+	), tsQuery, limit, (page-1)*limit,
+		"HighlightAll=true, "+highlightOptions,
+		"MaxFragments=2, MinWords=10, MaxWords=30, "+highlightOptions,
+	)
+	if err != nil {
+		s.log(ctx).Error("Failed to search items", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to search items")
+	}
+	defer rows.Close()
+
+	var hits []searchHit
+	for rows.Next() {
+		var hit searchHit
+		if err := rows.Scan(&hit.id, &hit.rank, &hit.title, &hit.description); err != nil {
+			s.log(ctx).Error("Failed to scan search result", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to search items")
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		s.log(ctx).Error("Failed to read search results", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to search items")
+	}
+
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.id
+	}
+	items, err := s.client.Item.Query().Where(item.IDIn(ids...)).All(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to fetch search results", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to search items")
+	}
+	byID := make(map[string]*ent.Item, len(items))
+	for _, itm := range items {
+		byID[itm.ID] = itm
+	}
+
+	// Сохраняем порядок по релевантности из поискового запроса
+	protoHits := make([]*proto.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		itm, ok := byID[hit.id]
+		if !ok {
+			continue
+		}
+		protoHits = append(protoHits, &proto.SearchHit{
+			Item:               toProtoItem(itm),
+			Rank:               hit.rank,
+			TitleHighlight:     highlight(hit.title),
+			DescriptionSnippet: highlight(hit.description),
+		})
+	}
+
+	return &proto.SearchItemsResponse{
+		Hits:       protoHits,
+		Total:      int32(total),
+		Page:       int32(page),
+		TotalPages: int32((total + limit - 1) / limit),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import "testing"
+
+func TestBuildTSQuery(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"dune", "dune:*"},
+		{"Sci-Fi  classics", "sci:* & fi:* & classics:*"},
+		{"a' | b:* & !c", "a:* & b:* & c:*"},
+		{" ,.! ", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := buildTSQuery(tt.input); got != tt.want {
+				t.Errorf("buildTSQuery(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name     string
+		headline string
+		want     string
+	}{
+		{"plain", "Dune", "Dune"},
+		{"match", highlightStart + "Dune" + highlightStop + " Messiah", "<mark>Dune</mark> Messiah"},
+		{
+			name:     "markup in data is escaped",
+			headline: `<script>alert("x")</script> ` + highlightStart + "dune" + highlightStop,
+			want:     "&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; <mark>dune</mark>",
+		},
+		{"entities", "Tom & Jerry's " + highlightStart + "<b>" + highlightStop, "Tom &amp; Jerry&#39;s <mark>&lt;b&gt;</mark>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlight(tt.headline); got != tt.want {
+				t.Errorf("highlight(%q) = %q, want %q", tt.headline, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/enttest"
+	"go.uber.org/zap"
+	"strings"
+	"testing"
+)
+
+// newTestService возвращает сервис поверх отдельной базы SQLite в памяти.
+// Запросы, которые есть только в PostgreSQL (блокировки строк, jsonb, полнотекстовый поиск),
+// проверяются на базе из testdb, остальная логика сервиса от диалекта не зависит.
+func newTestService(t *testing.T) (*CatalogService, *ent.Client) {
+	t.Helper()
+	name := strings.NewReplacer("/", "_", " ", "_").Replace(t.Name())
+	client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return NewCatalogService(client, zap.NewNop(), []byte("test-secret"), nil), client
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"strings"
+)
+
+// sortableFields задаёт соответствие между полями sort_by и колонками items.
+// Сортировка по полям вне этого списка запрещена.
+var sortableFields = map[string]string{
+	"rating":       item.FieldRating,
+	"review_count": item.FieldReviewCount,
+	"title":        item.FieldTitle,
+	"created_at":   item.FieldCreatedAt,
+	"updated_at":   item.FieldUpdatedAt,
+}
+
+// defaultSort используется, когда sort_by не передан
+var defaultSort = []sortKey{{field: item.FieldCreatedAt, desc: true}}
+
+type sortKey struct {
+	field string
+	desc  bool
+}
+
+// parseSort разбирает выражение вида "-rating,title".
+// Префикс "-" означает сортировку по убыванию, "+" или его отсутствие - по возрастанию.
+func parseSort(sortBy string) ([]sortKey, error) {
+	sortBy = strings.TrimSpace(sortBy)
+	if sortBy == "" {
+		return defaultSort, nil
+	}
+
+	parts := strings.Split(sortBy, ",")
+	keys := make([]sortKey, 0, len(parts))
+	seen := make(map[string]struct{}, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		desc := false
+		switch {
+		case strings.HasPrefix(part, "-"):
+			desc = true
+			part = part[1:]
+		case strings.HasPrefix(part, "+"):
+			part = part[1:]
+		}
+
+		column, ok := sortableFields[part]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort field %q", part)
+		}
+		if _, ok := seen[column]; ok {
+			return nil, fmt.Errorf("duplicate sort field %q", part)
+		}
+		seen[column] = struct{}{}
+		keys = append(keys, sortKey{field: column, desc: desc})
+	}
+	return keys, nil
+}
+
+// orderOptions превращает ключи сортировки в опции ent.
+// Последним всегда добавляется id, чтобы порядок был стабильным при равных значениях.
+func orderOptions(keys []sortKey) []item.OrderOption {
+	opts := make([]item.OrderOption, 0, len(keys)+1)
+	for _, key := range keys {
+		if key.desc {
+			opts = append(opts, ent.Desc(key.field))
+		} else {
+			opts = append(opts, ent.Asc(key.field))
+		}
+	}
+	return append(opts, ent.Asc(item.FieldID))
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"reflect"
+	"testing"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		sortBy  string
+		want    []sortKey
+		wantErr bool
+	}{
+		{sortBy: "", want: defaultSort},
+		{sortBy: "  ", want: defaultSort},
+		{sortBy: "rating", want: []sortKey{{field: item.FieldRating}}},
+		{sortBy: "+rating", want: []sortKey{{field: item.FieldRating}}},
+		{sortBy: "-rating, title", want: []sortKey{{field: item.FieldRating, desc: true}, {field: item.FieldTitle}}},
+		{sortBy: "-review_count,-created_at", want: []sortKey{{field: item.FieldReviewCount, desc: true}, {field: item.FieldCreatedAt, desc: true}}},
+		{sortBy: "price", wantErr: true},
+		{sortBy: "rating,-rating", wantErr: true},
+		{sortBy: "rating,", wantErr: true},
+		{sortBy: "id", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sortBy, func(t *testing.T) {
+			got, err := parseSort(tt.sortBy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSort(%q) error = %v, wantErr %v", tt.sortBy, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSort(%q) = %v, want %v", tt.sortBy, got, tt.want)
+			}
+		})
+	}
+}
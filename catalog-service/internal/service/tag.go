@@ -0,0 +1,542 @@
+package service
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/predicate"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/schema"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tagsynonym"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// mergeBatchSize - сколько элементов MergeTags переписывает за один запрос к базе
+	mergeBatchSize = 500
+	// syncTagsBatchSize ограничивает число параметров в запросах SyncTagRegistry
+	syncTagsBatchSize = 1000
+)
+
+// updatableTagFields - поля Tag, которые можно указать в update_mask
+var updatableTagFields = []string{"description", "synonyms"}
+
+var errTagNotFound = errors.New("tag not found")
+
+func (s *CatalogService) ListTags(ctx context.Context, req *proto.ListTagsRequest) (*proto.ListTagsResponse, error) {
+	if err := validateListTags(req); err != nil {
+		return nil, err
+	}
+
+	query := s.client.Tag.Query()
+	if key := schema.TagKey(req.Prefix); key != "" {
+		query = query.Where(tag.Or(
+			tag.KeyHasPrefix(key),
+			tag.HasSynonymsWith(tagsynonym.KeyHasPrefix(key)),
+		))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to count tags", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to count tags")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+
+	tags, err := query.
+		WithSynonyms().
+		Limit(limit).
+		Offset((page-1)*limit).
+		Order(ent.Desc(tag.FieldUsageCount), ent.Asc(tag.FieldName), ent.Asc(tag.FieldID)).
+		All(ctx)
+	if err != nil {
+		s.log(ctx).Error("Failed to fetch tags", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fetch tags")
+	}
+
+	protoTags := make([]*proto.Tag, len(tags))
+	for i, t := range tags {
+		protoTags[i] = toProtoTag(t)
+	}
+
+	return &proto.ListTagsResponse{
+		Tags:       protoTags,
+		Total:      int32(total),
+		Page:       int32(page),
+		TotalPages: int32((total + limit - 1) / limit),
+	}, nil
+}
+
+func (s *CatalogService) CreateTag(ctx context.Context, req *proto.CreateTagRequest) (*proto.Tag, error) {
+	if err := validateCreateTag(req); err != nil {
+		return nil, err
+	}
+
+	var t *ent.Tag
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		key := schema.TagKey(req.Name)
+		existing, err := schema.LookupTags(ctx, tx.Client(), []string{key})
+		if err != nil {
+			return err
+		}
+		if other, ok := existing[key]; ok {
+			return status.Errorf(codes.AlreadyExists, "tag %q already exists as %q", req.Name, other.Name)
+		}
+
+		t, err = tx.Tag.Create().
+			SetName(normalizeTagName(req.Name)).
+			SetKey(key).
+			SetDescription(req.Description).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return setTagSynonyms(ctx, tx, t, req.Synonyms)
+	})
+	if err != nil {
+		return nil, s.tagError(ctx, err, "failed to create tag")
+	}
+	return s.getTag(ctx, t.ID)
+}
+
+func (s *CatalogService) UpdateTag(ctx context.Context, req *proto.UpdateTagRequest) (*proto.Tag, error) {
+	paths := tagUpdatePaths(req)
+	if err := validateUpdateTag(req, paths); err != nil {
+		return nil, err
+	}
+
+	var t *ent.Tag
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		t, err = findTag(ctx, tx.Client(), req.Id)
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			switch path {
+			case "description":
+				if err := tx.Tag.UpdateOne(t).SetDescription(req.GetDescription()).Exec(ctx); err != nil {
+					return err
+				}
+			case "synonyms":
+				if _, err := tx.TagSynonym.Delete().Where(tagsynonym.TagID(t.ID)).Exec(ctx); err != nil {
+					return err
+				}
+				if err := setTagSynonyms(ctx, tx, t, req.Synonyms); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, s.tagError(ctx, err, "failed to update tag")
+	}
+	return s.getTag(ctx, t.ID)
+}
+
+// MergeTags переносит элементы, имена и синонимы тегов sources в target и удаляет sources.
+// Элементы переписываются через updateItemTx, поэтому получают новую версию, ревизию и событие.
+// Удалённые элементы не переписываются: их теги найдут target через синонимы после восстановления.
+func (s *CatalogService) MergeTags(ctx context.Context, req *proto.MergeTagsRequest) (*proto.MergeTagsResponse, error) {
+	if err := validateMergeTags(req); err != nil {
+		return nil, err
+	}
+
+	var (
+		target  *ent.Tag
+		updated int32
+	)
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		target, err = findTag(ctx, tx.Client(), req.Target)
+		if errors.Is(err, errTagNotFound) {
+			return status.Errorf(codes.NotFound, "tag %q not found", req.Target)
+		}
+		if err != nil {
+			return err
+		}
+		var sources []*ent.Tag
+		for _, name := range req.Sources {
+			source, err := findTag(ctx, tx.Client(), name)
+			if errors.Is(err, errTagNotFound) {
+				return status.Errorf(codes.NotFound, "tag %q not found", name)
+			}
+			if err != nil {
+				return err
+			}
+			if source.ID == target.ID {
+				return status.Errorf(codes.InvalidArgument, "tag %q cannot be merged into itself", name)
+			}
+			if !slices.ContainsFunc(sources, func(t *ent.Tag) bool { return t.ID == source.ID }) {
+				sources = append(sources, source)
+			}
+		}
+
+		// Блокировка не даёт двум слияниям с общими тегами переписывать элементы одновременно
+		ids := []string{target.ID}
+		for _, source := range sources {
+			ids = append(ids, source.ID)
+		}
+		if _, err := tx.Tag.Query().Where(tag.IDIn(ids...)).Order(tag.ByID()).ForUpdate().All(ctx); err != nil {
+			return err
+		}
+
+		updated, err = rewriteMergedItems(ctx, tx, sources, target)
+		if err != nil {
+			return err
+		}
+
+		sourceIDs := ids[1:]
+		if err := tx.TagSynonym.Update().Where(tagsynonym.TagIDIn(sourceIDs...)).SetTagID(target.ID).Exec(ctx); err != nil {
+			return err
+		}
+		// Остаток счётчиков - элементы со старыми написаниями тегов, которые не попали в реестр
+		leftover := 0
+		remaining, err := tx.Tag.Query().Where(tag.IDIn(sourceIDs...)).All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, source := range remaining {
+			leftover += source.UsageCount
+		}
+		if _, err := tx.Tag.Delete().Where(tag.IDIn(sourceIDs...)).Exec(ctx); err != nil {
+			return err
+		}
+		err = tx.TagSynonym.MapCreateBulk(sources, func(c *ent.TagSynonymCreate, i int) {
+			c.SetTagID(target.ID).SetName(sources[i].Name).SetKey(sources[i].Key)
+		}).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.Tag.UpdateOneID(target.ID).AddUsageCount(leftover).Exec(ctx)
+	})
+	if err != nil {
+		return nil, s.tagError(ctx, err, "failed to merge tags")
+	}
+
+	s.log(ctx).Info("Tags merged",
+		zap.String("target", target.Name),
+		zap.Strings("sources", req.Sources),
+		zap.Int32("items_updated", updated),
+	)
+
+	merged, err := s.getTag(ctx, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.MergeTagsResponse{
+		Tag:          merged,
+		ItemsUpdated: updated,
+	}, nil
+}
+
+// rewriteMergedItems заменяет у неудалённых элементов любые написания тегов sources именем target
+func rewriteMergedItems(ctx context.Context, tx *ent.Tx, sources []*ent.Tag, target *ent.Tag) (int32, error) {
+	keys := make(map[string]bool)
+	for _, source := range sources {
+		keys[source.Key] = true
+		synonyms, err := source.QuerySynonyms().All(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, synonym := range synonyms {
+			keys[synonym.Key] = true
+		}
+	}
+
+	// У элементов, сохранённых до появления реестра, теги могут быть записаны иначе,
+	// поэтому искать приходится по всем написаниям, которые встречаются в каталоге
+	usage, err := itemTagUsage(ctx, tx.QueryContext)
+	if err != nil {
+		return 0, err
+	}
+	var preds []predicate.Item
+	for spelling := range usage {
+		if keys[schema.TagKey(spelling)] {
+			preds = append(preds, hasTag(spelling))
+		}
+	}
+	if len(preds) == 0 {
+		return 0, nil
+	}
+
+	var updated int32
+	for {
+		// Переписанные элементы перестают подходить под условие, поэтому каждый раз берётся первая страница
+		items, err := tx.Item.Query().
+			Where(item.Or(preds...)).
+			Order(item.ByID()).
+			Limit(mergeBatchSize).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for _, itm := range items {
+			req := &proto.UpdateItemRequest{
+				Id:         itm.ID,
+				Tags:       mergedTags(itm.Tags, keys, target.Name),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+			}
+			if _, err := updateItemTx(ctx, tx, req, req.UpdateMask.Paths); err != nil {
+				return 0, err
+			}
+			updated++
+		}
+		if len(items) < mergeBatchSize {
+			return updated, nil
+		}
+	}
+}
+
+// mergedTags заменяет теги с ключами из keys на target, сохраняя порядок остальных.
+// Повторы target убирает хук canonicalizeItemTags при сохранении.
+func mergedTags(tags []string, keys map[string]bool, target string) []string {
+	merged := make([]string, len(tags))
+	for i, name := range tags {
+		merged[i] = name
+		if keys[schema.TagKey(name)] {
+			merged[i] = target
+		}
+	}
+	return merged
+}
+
+// SyncTagRegistry добавляет в реестр теги, которые есть у элементов, и пересчитывает usage_count.
+// Вызывается при старте сервиса: элементы, сохранённые до появления реестра, в нём не учтены,
+// а пересчёт заодно исправляет счётчики, если они разошлись с данными.
+func SyncTagRegistry(ctx context.Context, client *ent.Client) error {
+	usage, err := itemTagUsage(ctx, client.QueryContext)
+	if err != nil {
+		return err
+	}
+	spellings := make([]string, 0, len(usage))
+	for spelling := range usage {
+		spellings = append(spellings, spelling)
+	}
+
+	counts := make(map[string]int)
+	for batch := range slices.Chunk(spellings, syncTagsBatchSize) {
+		if _, err := schema.ResolveTags(ctx, client, batch, true); err != nil {
+			return err
+		}
+		keys := make([]string, len(batch))
+		for i, spelling := range batch {
+			keys[i] = schema.TagKey(spelling)
+		}
+		tags, err := schema.LookupTags(ctx, client, keys)
+		if err != nil {
+			return err
+		}
+		for _, spelling := range batch {
+			if t, ok := tags[schema.TagKey(spelling)]; ok {
+				counts[t.ID] += usage[spelling]
+			}
+		}
+	}
+
+	// Теги с одинаковым числом элементов обновляются одним запросом
+	byCount := make(map[int][]string)
+	for id, count := range counts {
+		byCount[count] = append(byCount[count], id)
+	}
+	return withTx(ctx, client, func(tx *ent.Tx) error {
+		for count, batch := range byCount {
+			for chunk := range slices.Chunk(batch, syncTagsBatchSize) {
+				err := tx.Tag.Update().
+					Where(tag.IDIn(chunk...), tag.UsageCountNEQ(count)).
+					SetUsageCount(count).
+					Exec(ctx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		unused, err := tx.Tag.Query().Where(tag.UsageCountNEQ(0)).IDs(ctx)
+		if err != nil {
+			return err
+		}
+		unused = slices.DeleteFunc(unused, func(id string) bool {
+			_, ok := counts[id]
+			return ok
+		})
+		if len(unused) == 0 {
+			return nil
+		}
+		return tx.Tag.Update().Where(tag.IDIn(unused...)).SetUsageCount(0).Exec(ctx)
+	})
+}
+
+// itemTagUsage возвращает все написания тегов у неудалённых элементов и число элементов с каждым
+func itemTagUsage(ctx context.Context, query func(ctx context.Context, query string, args ...any) (*stdsql.Rows, error)) (map[string]int, error) {
+	rows, err := query(ctx, fmt.Sprintf(
+		"SELECT tag, count(*) FROM %s, jsonb_array_elements_text(%s) AS tag WHERE %s IS NULL GROUP BY tag",
+		item.Table, item.FieldTags, item.FieldDeletedAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	usage := make(map[string]int)
+	for rows.Next() {
+		var (
+			spelling string
+			count    int
+		)
+		if err := rows.Scan(&spelling, &count); err != nil {
+			return nil, err
+		}
+		usage[spelling] = count
+	}
+	return usage, rows.Err()
+}
+
+// normalizeTagName убирает пробелы по краям и повторы пробелов внутри имени
+func normalizeTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// canonicalTags приводит теги из фильтра к каноническим именам, не регистрируя неизвестные
+func (s *CatalogService) canonicalTags(ctx context.Context, tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return tags, nil
+	}
+	return schema.ResolveTags(ctx, s.client, tags, false)
+}
+
+// setTagSynonyms добавляет синонимы тегу t. Синоним, совпадающий с другим тегом или его синонимом,
+// отклоняется: такие теги нужно объединить через MergeTags.
+func setTagSynonyms(ctx context.Context, tx *ent.Tx, t *ent.Tag, synonyms []string) error {
+	var (
+		names []string
+		keys  []string
+	)
+	for _, name := range synonyms {
+		name = normalizeTagName(name)
+		key := schema.TagKey(name)
+		if key == t.Key || slices.Contains(keys, key) {
+			continue
+		}
+		names = append(names, name)
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	existing, err := schema.LookupTags(ctx, tx.Client(), keys)
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if other, ok := existing[key]; ok && other.ID != t.ID {
+			return status.Errorf(codes.AlreadyExists, "synonym %q already refers to tag %q, merge the tags instead", names[i], other.Name)
+		}
+	}
+
+	return tx.TagSynonym.MapCreateBulk(names, func(c *ent.TagSynonymCreate, i int) {
+		c.SetTagID(t.ID).SetName(names[i]).SetKey(keys[i])
+	}).Exec(ctx)
+}
+
+// findTag ищет тег по id, а если такого нет - по имени или синониму в любом написании
+func findTag(ctx context.Context, client *ent.Client, idOrName string) (*ent.Tag, error) {
+	t, err := client.Tag.Get(ctx, idOrName)
+	if err == nil {
+		return t, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+	key := schema.TagKey(idOrName)
+	tags, err := schema.LookupTags(ctx, client, []string{key})
+	if err != nil {
+		return nil, err
+	}
+	if t, ok := tags[key]; ok {
+		return t, nil
+	}
+	return nil, errTagNotFound
+}
+
+func (s *CatalogService) getTag(ctx context.Context, id string) (*proto.Tag, error) {
+	t, err := s.client.Tag.Query().Where(tag.ID(id)).WithSynonyms().Only(ctx)
+	if err != nil {
+		return nil, s.tagError(ctx, err, "failed to fetch tag")
+	}
+	return toProtoTag(t), nil
+}
+
+// tagUpdatePaths работает так же, как itemUpdatePaths
+func tagUpdatePaths(req *proto.UpdateTagRequest) []string {
+	if req.UpdateMask == nil {
+		var paths []string
+		if req.Description != nil {
+			paths = append(paths, "description")
+		}
+		if len(req.Synonyms) > 0 {
+			paths = append(paths, "synonyms")
+		}
+		return paths
+	}
+
+	for _, path := range req.UpdateMask.Paths {
+		if path == "*" {
+			return updatableTagFields
+		}
+	}
+	return req.UpdateMask.Paths
+}
+
+// tagError переводит ошибку операции с тегом в gRPC статус
+func (s *CatalogService) tagError(ctx context.Context, err error, message string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, errTagNotFound), ent.IsNotFound(err):
+		return status.Error(codes.NotFound, "tag not found")
+	case ent.IsConstraintError(err):
+		return status.Error(codes.Aborted, "tag registry was changed concurrently, retry the request")
+	case ent.IsValidationError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.log(ctx).Error("Failed to change tag", zap.String("operation", message), zap.Error(err))
+	return status.Error(codes.Internal, message)
+}
+
+func toProtoTag(t *ent.Tag) *proto.Tag {
+	synonyms := make([]string, len(t.Edges.Synonyms))
+	for i, synonym := range t.Edges.Synonyms {
+		synonyms[i] = synonym.Name
+	}
+	slices.Sort(synonyms)
+	return &proto.Tag{
+		Id:          t.ID,
+		Name:        t.Name,
+		Synonyms:    synonyms,
+		Description: t.Description,
+		UsageCount:  int32(t.UsageCount),
+		CreatedAt:   t.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   t.UpdatedAt.Format(time.RFC3339),
+	}
+}
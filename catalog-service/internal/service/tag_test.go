@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/tag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+func TestMergedTags(t *testing.T) {
+	keys := map[string]bool{"scifi": true, "sf": true}
+
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"no tags", nil, []string{}},
+		{"nothing to merge", []string{"space", "opera"}, []string{"space", "opera"}},
+		{"source spelling", []string{"Sci-Fi", "space"}, []string{"Science Fiction", "space"}},
+		{"synonym and source keep order", []string{"space", "sf", "sci fi"}, []string{"space", "Science Fiction", "Science Fiction"}},
+		{"target stays", []string{"Science Fiction", "SF"}, []string{"Science Fiction", "Science Fiction"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergedTags(tt.tags, keys, "Science Fiction"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergedTags(%v) = %v, want %v", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestItemTagsCanonicalized(t *testing.T) {
+	s, client := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := s.CreateTag(ctx, &proto.CreateTagRequest{Name: "Science Fiction", Synonyms: []string{"SF"}}); err != nil {
+		t.Fatalf("CreateTag: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"registered spelling", []string{"science-fiction"}, []string{"Science Fiction"}},
+		{"synonym", []string{"sf"}, []string{"Science Fiction"}},
+		{"spellings of one tag collapse", []string{"SF", "Science_Fiction", "space opera"}, []string{"Science Fiction", "space opera"}},
+		{"other spelling of a new tag", []string{"Space-Opera"}, []string{"space opera"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itm, err := s.CreateItem(ctx, &proto.CreateItemRequest{Title: tt.name, Tags: tt.tags})
+			if err != nil {
+				t.Fatalf("CreateItem: %v", err)
+			}
+			if !reflect.DeepEqual(itm.Tags, tt.want) {
+				t.Errorf("tags = %v, want %v", itm.Tags, tt.want)
+			}
+		})
+	}
+
+	usage := map[string]int{}
+	for _, tg := range client.Tag.Query().Order(tag.ByName()).AllX(ctx) {
+		usage[tg.Name] = tg.UsageCount
+	}
+	if want := map[string]int{"Science Fiction": 3, "space opera": 2}; !reflect.DeepEqual(usage, want) {
+		t.Errorf("usage counts = %v, want %v", usage, want)
+	}
+
+	resp, err := s.GetItems(ctx, &proto.GetItemsRequest{Tags: []string{"sf"}, SortBy: "title"})
+	if err != nil {
+		t.Fatalf("GetItems: %v", err)
+	}
+	if resp.Total != 3 {
+		t.Errorf("items found by synonym = %d, want 3", resp.Total)
+	}
+
+	_, err = s.CreateTag(ctx, &proto.CreateTagRequest{Name: "science fiction"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateTag for another spelling: error = %v, want AlreadyExists", err)
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/dataio"
+	"time"
+)
+
+// itemColumn - поле элемента, которое можно выгрузить в файл
+type itemColumn struct {
+	field string
+	kind  dataio.Kind
+	value func(itm *ent.Item) any
+}
+
+// itemColumns - поля элемента в порядке колонок экспорта по умолчанию
+var itemColumns = []itemColumn{
+	{"id", dataio.String, func(itm *ent.Item) any { return itm.ID }},
+	{"external_id", dataio.String, func(itm *ent.Item) any { return stringValue(itm.ExternalID) }},
+	{"title", dataio.String, func(itm *ent.Item) any { return itm.Title }},
+	{"description", dataio.String, func(itm *ent.Item) any { return itm.Description }},
+	{"tags", dataio.Strings, func(itm *ent.Item) any { return itm.Tags }},
+	{"image_url", dataio.String, func(itm *ent.Item) any { return itm.ImageURL }},
+	{"rating", dataio.Float, func(itm *ent.Item) any { return itm.Rating }},
+	{"review_count", dataio.Int, func(itm *ent.Item) any { return int64(itm.ReviewCount) }},
+	{"version", dataio.Int, func(itm *ent.Item) any { return itm.Version }},
+	{"created_at", dataio.String, func(itm *ent.Item) any { return itm.CreatedAt.Format(time.RFC3339) }},
+	{"updated_at", dataio.String, func(itm *ent.Item) any { return itm.UpdatedAt.Format(time.RFC3339) }},
+}
+
+// importableItemFields - поля, которые импорт берёт из файла.
+// external_id - ключ для upsert, остальные совпадают с редактируемыми полями.
+var importableItemFields = append([]string{"external_id"}, updatableItemFields...)
+
+func findItemColumn(field string) (itemColumn, bool) {
+	for _, column := range itemColumns {
+		if column.field == field {
+			return column, true
+		}
+	}
+	return itemColumn{}, false
+}
+
+func dataFormat(format proto.DataFormat) (dataio.Format, bool) {
+	switch format {
+	case proto.DataFormat_DATA_FORMAT_CSV:
+		return dataio.CSV, true
+	case proto.DataFormat_DATA_FORMAT_JSONL:
+		return dataio.JSONL, true
+	case proto.DataFormat_DATA_FORMAT_PARQUET:
+		return dataio.Parquet, true
+	default:
+		return 0, false
+	}
+}
+
+// columnName возвращает имя колонки файла для поля; без явного имени колонка называется как поле
+func columnName(mapping *proto.ColumnMapping) string {
+	if mapping.Column != "" {
+		return mapping.Column
+	}
+	return mapping.Field
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
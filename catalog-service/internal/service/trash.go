@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/schema"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RestoreItem возвращает удалённый элемент из корзины.
+// Восстановление существующего элемента ничего не меняет и возвращает его как есть.
+func (s *CatalogService) RestoreItem(ctx context.Context, req *proto.RestoreItemRequest) (*proto.Item, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	var itm *ent.Item
+	err := withTx(ctx, s.client, func(tx *ent.Tx) error {
+		var err error
+		itm, err = tx.Item.UpdateOneID(req.Id).
+			Where(item.DeletedAtNotNil()).
+			ClearDeletedAt().
+			AddVersion(1).
+			Save(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return err
+		}
+		// Для подписчиков восстановленный элемент появляется в каталоге заново
+		return enqueueItemEvent(ctx, tx, EventItemCreated, itm)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Элемента нет в корзине: либо он не удалялся, либо его нет совсем
+			return s.GetItem(ctx, &proto.GetItemRequest{Id: req.Id})
+		}
+		s.log(ctx).Error("Failed to restore item", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to restore item")
+	}
+
+	return toProtoItem(itm), nil
+}
+
+// ListDeletedItems возвращает элементы в корзине, сначала удалённые последними
+func (s *CatalogService) ListDeletedItems(ctx context.Context, req *proto.ListDeletedItemsRequest) (*proto.ListDeletedItemsResponse, error) {
+	if err := validateListDeletedItems(req); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+
+	query := s.client.Item.Query().Where(item.DeletedAtNotNil())
+	skipCtx := schema.SkipSoftDelete(ctx)
+
+	total, err := query.Clone().Count(skipCtx)
+	if err != nil {
+		s.log(ctx).Error("Failed to count deleted items", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to count deleted items")
+	}
+
+	items, err := query.
+		Order(ent.Desc(item.FieldDeletedAt), ent.Asc(item.FieldID)).
+		Limit(limit).
+		Offset((page - 1) * limit).
+		All(skipCtx)
+	if err != nil {
+		s.log(ctx).Error("Failed to fetch deleted items", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fetch deleted items")
+	}
+
+	protoItems := make([]*proto.Item, len(items))
+	for i, itm := range items {
+		protoItems[i] = toProtoItem(itm)
+	}
+
+	return &proto.ListDeletedItemsResponse{
+		Items:      protoItems,
+		Total:      int32(total),
+		Page:       int32(page),
+		TotalPages: int32((total + limit - 1) / limit),
+	}, nil
+}
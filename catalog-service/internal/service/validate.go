@@ -0,0 +1,391 @@
+package service
+
+import (
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/schema"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/validation"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	maxTitleLength       = 200
+	maxDescriptionLength = 5000
+	maxImageURLLength    = 2048
+	maxTags              = 20
+	maxTagLength         = 50
+	maxReviewTextLength  = 5000
+	maxAuthorLength      = 200
+	maxWatchIDs          = 100
+	maxBatchSize         = 1000
+	maxExternalIDLength  = 200
+	maxColumnNameLength  = 200
+	maxListSeparator     = 5
+	maxCategoryName      = 100
+	maxSlugLength        = 100
+	maxTagSynonyms       = 20
+	maxTagDescription    = 1000
+	maxMergeSources      = 100
+	// maxPageLimit учитывает search-service и recommendation-service, которые читают каталог страницами по 500
+	maxPageLimit = 500
+)
+
+// validateTagName проверяет имя тега так же, как теги элемента, и что после TagKey от него что-то остаётся
+func validateTagName(v *validation.Errors, field, name string) {
+	if !v.Required(field, name) {
+		return
+	}
+	v.MaxLength(field, name, maxTagLength)
+	if schema.TagKey(name) == "" {
+		v.Add(field, "must contain at least one letter, digit or symbol")
+	}
+}
+
+// slugPattern - строчные латинские буквы и цифры, разделённые одиночными дефисами
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+func validateGetItems(req *proto.GetItemsRequest) error {
+	var v validation.Errors
+	v.Page(req.Page, req.Limit, maxPageLimit)
+	v.TagFilter("tags", req.Tags, maxTags, maxTagLength)
+	if req.IncludeDescendants && req.Category == "" {
+		v.Add("include_descendants", "requires category")
+	}
+	return v.Err()
+}
+
+func validateCreateItem(req *proto.CreateItemRequest) error {
+	var v validation.Errors
+	if v.Required("title", req.Title) {
+		v.MaxLength("title", req.Title, maxTitleLength)
+	}
+	v.MaxLength("description", req.Description, maxDescriptionLength)
+	v.Tags("tags", req.Tags, maxTags, maxTagLength)
+	v.URL("image_url", req.ImageUrl, maxImageURLLength)
+	return v.Err()
+}
+
+func validateUpdateItem(req *proto.UpdateItemRequest, paths []string) error {
+	var v validation.Errors
+	v.Required("id", req.Id)
+
+	seen := make(map[string]bool, len(paths))
+	for i, path := range paths {
+		if !isUpdatableItemField(path) {
+			v.Add(fmt.Sprintf("update_mask.paths[%d]", i), "unknown field %q, expected one of %s or *", path, strings.Join(updatableItemFields, ", "))
+			continue
+		}
+		if seen[path] {
+			v.Add(fmt.Sprintf("update_mask.paths[%d]", i), "duplicate field %q", path)
+			continue
+		}
+		seen[path] = true
+
+		switch path {
+		case "title":
+			if v.Required("title", req.GetTitle()) {
+				v.MaxLength("title", req.GetTitle(), maxTitleLength)
+			}
+		case "description":
+			v.MaxLength("description", req.GetDescription(), maxDescriptionLength)
+		case "tags":
+			v.Tags("tags", req.Tags, maxTags, maxTagLength)
+		case "image_url":
+			v.URL("image_url", req.GetImageUrl(), maxImageURLLength)
+		}
+	}
+	return v.Err()
+}
+
+func validateListDeletedItems(req *proto.ListDeletedItemsRequest) error {
+	var v validation.Errors
+	v.Page(req.Page, req.Limit, maxPageLimit)
+	return v.Err()
+}
+
+func validateSearchItems(req *proto.SearchItemsRequest) error {
+	var v validation.Errors
+	if v.Required("query", req.Query) {
+		v.MaxLength("query", req.Query, maxTitleLength)
+	}
+	v.Page(req.Page, req.Limit, maxPageLimit)
+	return v.Err()
+}
+
+func validateCreateReview(req *proto.CreateReviewRequest) error {
+	var v validation.Errors
+	v.Required("item_id", req.ItemId)
+	if v.Required("author", req.Author) {
+		v.MaxLength("author", req.Author, maxAuthorLength)
+	}
+	v.Range("score", int64(req.Score), 1, 5)
+	v.MaxLength("text", req.Text, maxReviewTextLength)
+	return v.Err()
+}
+
+func validateListReviews(req *proto.ListReviewsRequest) error {
+	var v validation.Errors
+	v.Required("item_id", req.ItemId)
+	v.Page(req.Page, req.Limit, maxPageLimit)
+	return v.Err()
+}
+
+func validateListItemRevisions(req *proto.ListItemRevisionsRequest) error {
+	var v validation.Errors
+	v.Required("item_id", req.ItemId)
+	v.Page(req.Page, req.Limit, maxPageLimit)
+	return v.Err()
+}
+
+func validateRevertItem(req *proto.RevertItemRequest) error {
+	var v validation.Errors
+	v.Required("item_id", req.ItemId)
+	v.Required("revision_id", req.RevisionId)
+	return v.Err()
+}
+
+func validateWatchItems(req *proto.WatchItemsRequest) error {
+	var v validation.Errors
+	v.TagFilter("tags", req.Tags, maxTags, maxTagLength)
+	if len(req.Ids) > maxWatchIDs {
+		v.Add("ids", "must contain at most %d ids", maxWatchIDs)
+	}
+	for i, id := range req.Ids {
+		v.Required(fmt.Sprintf("ids[%d]", i), id)
+	}
+	if req.AfterSequence < 0 {
+		v.Add("after_sequence", "must not be negative")
+	}
+	return v.Err()
+}
+
+func validateBatchSize(n int) error {
+	var v validation.Errors
+	if n == 0 {
+		v.Add("items", "is required")
+	} else if n > maxBatchSize {
+		v.Add("items", "must contain at most %d items", maxBatchSize)
+	}
+	return v.Err()
+}
+
+func validateBatchGetItems(req *proto.BatchGetItemsRequest) error {
+	var v validation.Errors
+	if len(req.Ids) == 0 {
+		v.Add("ids", "is required")
+	} else if len(req.Ids) > maxBatchSize {
+		v.Add("ids", "must contain at most %d ids", maxBatchSize)
+	}
+	for i, id := range req.Ids {
+		v.Required(fmt.Sprintf("ids[%d]", i), id)
+	}
+	return v.Err()
+}
+
+func validateImportOptions(opts *proto.ImportOptions) error {
+	var v validation.Errors
+	if _, ok := dataFormat(opts.Format); !ok {
+		v.Add("options.format", "must be one of csv, jsonl or parquet")
+	}
+	validateColumnMappings(&v, "options.columns", opts.Columns, importableItemFields)
+	v.MaxLength("options.list_separator", opts.ListSeparator, maxListSeparator)
+	return v.Err()
+}
+
+func validateExportItems(req *proto.ExportItemsRequest) error {
+	var v validation.Errors
+	if _, ok := dataFormat(req.Format); !ok {
+		v.Add("format", "must be one of csv, jsonl or parquet")
+	}
+	fields := make([]string, len(itemColumns))
+	for i, column := range itemColumns {
+		fields[i] = column.field
+	}
+	validateColumnMappings(&v, "columns", req.Columns, fields)
+	v.TagFilter("tags", req.Tags, maxTags, maxTagLength)
+	v.MaxLength("list_separator", req.ListSeparator, maxListSeparator)
+	return v.Err()
+}
+
+// validateColumnMappings проверяет, что каждое поле и каждая колонка файла встречаются не больше одного раза
+func validateColumnMappings(v *validation.Errors, field string, mappings []*proto.ColumnMapping, allowed []string) {
+	fields := make(map[string]int, len(mappings))
+	columns := make(map[string]int, len(mappings))
+	for i, mapping := range mappings {
+		name := fmt.Sprintf("%s[%d]", field, i)
+		if !slices.Contains(allowed, mapping.Field) {
+			v.Add(name+".field", "unknown field %q, expected one of %s", mapping.Field, strings.Join(allowed, ", "))
+			continue
+		}
+		if first, ok := fields[mapping.Field]; ok {
+			v.Add(name+".field", "duplicates %s[%d].field", field, first)
+			continue
+		}
+		fields[mapping.Field] = i
+
+		column := columnName(mapping)
+		v.MaxLength(name+".column", column, maxColumnNameLength)
+		if first, ok := columns[column]; ok {
+			v.Add(name+".column", "duplicates %s[%d].column", field, first)
+			continue
+		}
+		columns[column] = i
+	}
+}
+
+func validateCreateCategory(req *proto.CreateCategoryRequest, slug string) error {
+	var v validation.Errors
+	if v.Required("name", strings.TrimSpace(req.Name)) {
+		v.MaxLength("name", req.Name, maxCategoryName)
+	}
+	if req.Slug == "" && req.Name != "" && slug == "" {
+		v.Add("slug", "cannot be derived from name, set it explicitly")
+	} else if slug != "" {
+		validateSlug(&v, slug)
+	}
+	v.MaxLength("description", req.Description, maxDescriptionLength)
+	if req.Position < 0 {
+		v.Add("position", "must not be negative")
+	}
+	return v.Err()
+}
+
+func validateUpdateCategory(req *proto.UpdateCategoryRequest, paths []string) error {
+	var v validation.Errors
+	v.Required("id", req.Id)
+
+	seen := make(map[string]bool, len(paths))
+	for i, path := range paths {
+		if !slices.Contains(updatableCategoryFields, path) {
+			v.Add(fmt.Sprintf("update_mask.paths[%d]", i), "unknown field %q, expected one of %s or *", path, strings.Join(updatableCategoryFields, ", "))
+			continue
+		}
+		if seen[path] {
+			v.Add(fmt.Sprintf("update_mask.paths[%d]", i), "duplicate field %q", path)
+			continue
+		}
+		seen[path] = true
+
+		switch path {
+		case "name":
+			if v.Required("name", strings.TrimSpace(req.GetName())) {
+				v.MaxLength("name", req.GetName(), maxCategoryName)
+			}
+		case "slug":
+			if v.Required("slug", req.GetSlug()) {
+				validateSlug(&v, req.GetSlug())
+			}
+		case "description":
+			v.MaxLength("description", req.GetDescription(), maxDescriptionLength)
+		case "position":
+			if req.GetPosition() < 0 {
+				v.Add("position", "must not be negative")
+			}
+		}
+	}
+	return v.Err()
+}
+
+func validateSlug(v *validation.Errors, slug string) {
+	if len(slug) > maxSlugLength {
+		v.MaxLength("slug", slug, maxSlugLength)
+		return
+	}
+	if !slugPattern.MatchString(slug) {
+		v.Add("slug", "must contain only lowercase latin letters and digits separated by single hyphens")
+	}
+}
+
+func validateListCategories(req *proto.ListCategoriesRequest) error {
+	var v validation.Errors
+	v.Page(req.Page, req.Limit, maxPageLimit)
+	return v.Err()
+}
+
+func validateGetCategoryTree(req *proto.GetCategoryTreeRequest) error {
+	var v validation.Errors
+	if req.MaxDepth < 0 {
+		v.Add("max_depth", "must not be negative")
+	}
+	return v.Err()
+}
+
+func validateCategoryItems(req *proto.CategoryItemsRequest) error {
+	var v validation.Errors
+	v.Required("category_id", req.CategoryId)
+	if len(req.ItemIds) == 0 {
+		v.Add("item_ids", "is required")
+	} else if len(req.ItemIds) > maxBatchSize {
+		v.Add("item_ids", "must contain at most %d ids", maxBatchSize)
+	}
+	for i, id := range req.ItemIds {
+		v.Required(fmt.Sprintf("item_ids[%d]", i), id)
+	}
+	return v.Err()
+}
+
+func validateListTags(req *proto.ListTagsRequest) error {
+	var v validation.Errors
+	v.Page(req.Page, req.Limit, maxPageLimit)
+	v.MaxLength("prefix", req.Prefix, maxTagLength)
+	return v.Err()
+}
+
+func validateCreateTag(req *proto.CreateTagRequest) error {
+	var v validation.Errors
+	validateTagName(&v, "name", req.Name)
+	validateTagSynonyms(&v, req.Synonyms)
+	v.MaxLength("description", req.Description, maxTagDescription)
+	return v.Err()
+}
+
+func validateUpdateTag(req *proto.UpdateTagRequest, paths []string) error {
+	var v validation.Errors
+	v.Required("id", req.Id)
+
+	seen := make(map[string]bool, len(paths))
+	for i, path := range paths {
+		if !slices.Contains(updatableTagFields, path) {
+			v.Add(fmt.Sprintf("update_mask.paths[%d]", i), "unknown field %q, expected one of %s or *", path, strings.Join(updatableTagFields, ", "))
+			continue
+		}
+		if seen[path] {
+			v.Add(fmt.Sprintf("update_mask.paths[%d]", i), "duplicate field %q", path)
+			continue
+		}
+		seen[path] = true
+
+		switch path {
+		case "description":
+			v.MaxLength("description", req.GetDescription(), maxTagDescription)
+		case "synonyms":
+			validateTagSynonyms(&v, req.Synonyms)
+		}
+	}
+	return v.Err()
+}
+
+func validateTagSynonyms(v *validation.Errors, synonyms []string) {
+	if len(synonyms) > maxTagSynonyms {
+		v.Add("synonyms", "must contain at most %d synonyms", maxTagSynonyms)
+	}
+	for i, synonym := range synonyms {
+		validateTagName(v, fmt.Sprintf("synonyms[%d]", i), synonym)
+	}
+}
+
+func validateMergeTags(req *proto.MergeTagsRequest) error {
+	var v validation.Errors
+	v.Required("target", req.Target)
+	if len(req.Sources) == 0 {
+		v.Add("sources", "is required")
+	} else if len(req.Sources) > maxMergeSources {
+		v.Add("sources", "must contain at most %d tags", maxMergeSources)
+	}
+	for i, source := range req.Sources {
+		v.Required(fmt.Sprintf("sources[%d]", i), source)
+	}
+	return v.Err()
+}
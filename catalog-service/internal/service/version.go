@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/item"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+)
+
+// ReasonVersionMismatch - причина в google.rpc.ErrorInfo, когда клиент изменяет устаревшую версию элемента
+const ReasonVersionMismatch = "VERSION_MISMATCH"
+
+func errVersionMismatch(expected, current int64) error {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("item version mismatch: expected %d, current %d", expected, current))
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: ReasonVersionMismatch,
+		Domain: "catalog",
+		Metadata: map[string]string{
+			"current_version": strconv.FormatInt(current, 10),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// missingItemError объясняет, почему условное изменение не затронуло ни одной строки:
+// элемента нет или у него другая версия. Внутри транзакции client должен быть tx.Client().
+func (s *CatalogService) missingItemError(ctx context.Context, client *ent.Client, id string, expected *int64) error {
+	if expected == nil {
+		return status.Error(codes.NotFound, "item not found")
+	}
+
+	itm, err := client.Item.Query().
+		Where(item.ID(id)).
+		Select(item.FieldVersion).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return status.Error(codes.NotFound, "item not found")
+		}
+		s.log(ctx).Error("Failed to get item version", zap.Error(err))
+		return status.Error(codes.Internal, "failed to get item version")
+	}
+	return errVersionMismatch(*expected, itm.Version)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"errors"
+	"github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/internal/watch"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// backfillBatchSize - сколько пропущенных изменений читается за один запрос при возобновлении
+const backfillBatchSize = 500
+
+// WatchItems отправляет изменения элементов по мере их появления.
+// С after_sequence поток сначала догоняет пропущенные изменения из ленты, затем переходит к новым.
+func (s *CatalogService) WatchItems(req *proto.WatchItemsRequest, stream proto.CatalogService_WatchItemsServer) error {
+	ctx := stream.Context()
+	if err := validateWatchItems(req); err != nil {
+		return err
+	}
+
+	// Изменения несут теги в каноническом написании, поэтому фильтр приводится к нему же
+	tags, err := s.canonicalTags(ctx, normalizeTags(req.Tags))
+	if err != nil {
+		s.log(ctx).Error("Failed to resolve tags", zap.Error(err))
+		return status.Error(codes.Internal, "failed to watch items")
+	}
+	sub, err := s.hub.Subscribe(ctx, watch.Filter{
+		Tags: tags,
+		IDs:  req.Ids,
+	})
+	if err != nil {
+		if errors.Is(err, watch.ErrClosed) {
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+		return status.FromContextError(err).Err()
+	}
+	defer s.hub.Unsubscribe(sub)
+
+	last := req.AfterSequence
+	if last > 0 && last < sub.Start() {
+		retained, err := s.hub.Retained(ctx, last)
+		if err != nil {
+			s.log(ctx).Error("Failed to check change feed retention", zap.Error(err))
+			return status.Error(codes.Internal, "failed to watch items")
+		}
+		if !retained {
+			return status.Errorf(codes.OutOfRange, "changes after sequence %d are no longer available, reload items and watch from sequence %d", last, sub.Start())
+		}
+
+		for last < sub.Start() {
+			changes, err := s.hub.Backfill(ctx, last, sub.Start(), backfillBatchSize)
+			if err != nil {
+				s.log(ctx).Error("Failed to read change feed", zap.Error(err))
+				return status.Error(codes.Internal, "failed to watch items")
+			}
+			for _, change := range changes {
+				if sub.Filter().Match(change) {
+					if err := s.sendItemChange(stream, change); err != nil {
+						return err
+					}
+				}
+				last = change.ID
+			}
+			if len(changes) < backfillBatchSize {
+				break
+			}
+		}
+	}
+
+	// Заголовки отправляются сразу, чтобы клиент отличил принятую подписку от ошибки,
+	// не дожидаясь первого изменения
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case change, ok := <-sub.Events():
+			if !ok {
+				if errors.Is(sub.Err(), watch.ErrSlowSubscriber) {
+					return status.Errorf(codes.ResourceExhausted, "watcher fell behind, resume from sequence %d", last)
+				}
+				return status.Error(codes.Unavailable, "server is shutting down")
+			}
+			// Изменения, уже отправленные при догоне или полученные клиентом от другой реплики
+			if change.ID <= last {
+				continue
+			}
+			if err := s.sendItemChange(stream, change); err != nil {
+				return err
+			}
+			last = change.ID
+		}
+	}
+}
+
+func (s *CatalogService) sendItemChange(stream proto.CatalogService_WatchItemsServer, change *ent.ItemChange) error {
+	var event proto.ItemEvent
+	if err := protojson.Unmarshal(change.Payload, &event); err != nil {
+		s.log(stream.Context()).Error("Failed to decode item change", zap.Int64("sequence", change.ID), zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to decode change %d", change.ID)
+	}
+	event.Sequence = change.ID
+	return stream.Send(&event)
+}
@@ -0,0 +1,69 @@
+// Package testdb открывает для тестов клиент ent поверх PostgreSQL.
+// Нужен там, где SQLite не подходит: jsonb операторы, блокировки строк, advisory lock.
+package testdb
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"entgo.io/ent/dialect"
+	_ "github.com/lib/pq"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/enttest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+// EnvDatabaseURL - переменная окружения со строкой подключения к тестовой базе
+const EnvDatabaseURL = "TEST_DATABASE_URL"
+
+// Open создаёт для теста отдельную схему, применяет к ней миграции ent и удаляет её после теста.
+// Без TEST_DATABASE_URL тест пропускается.
+func Open(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := os.Getenv(EnvDatabaseURL)
+	if dsn == "" {
+		t.Skip(EnvDatabaseURL + " is not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		t.Fatalf("generate schema name: %v", err)
+	}
+	name := "test_" + hex.EncodeToString(suffix)
+	if _, err := db.Exec("CREATE SCHEMA " + name); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := db.Exec("DROP SCHEMA " + name + " CASCADE"); err != nil {
+			t.Errorf("drop schema: %v", err)
+		}
+	})
+
+	client := enttest.Open(t, dialect.Postgres, withSearchPath(dsn, name))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// withSearchPath добавляет search_path в строку подключения: lib/pq передаёт его серверу
+// при подключении, поэтому все соединения пула работают в схеме теста
+func withSearchPath(dsn, schema string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err == nil {
+			q := u.Query()
+			q.Set("search_path", schema)
+			u.RawQuery = q.Encode()
+			return u.String()
+		}
+	}
+	return dsn + " search_path=" + schema
+}
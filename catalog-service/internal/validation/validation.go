@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// Errors собирает нарушения по всем полям запроса, чтобы клиент получил их одним ответом,
+// а не исправлял ошибки по одной
+type Errors struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+// Add добавляет нарушение для поля. field - имя поля в proto, для элементов списка - "tags[2]".
+func (e *Errors) Add(field, format string, args ...any) {
+	e.violations = append(e.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// Err возвращает codes.InvalidArgument с google.rpc.BadRequest в деталях или nil, если нарушений нет
+func (e *Errors) Err() error {
+	if len(e.violations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+e.violations[0].Field+": "+e.violations[0].Description)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: e.violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// Required проверяет, что строка не пустая и не состоит из одних пробелов
+func (e *Errors) Required(field, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		e.Add(field, "must not be empty")
+		return false
+	}
+	return true
+}
+
+// MaxLength проверяет длину строки в символах, а не в байтах
+func (e *Errors) MaxLength(field, value string, max int) {
+	if n := utf8.RuneCountInString(value); n > max {
+		e.Add(field, "must be at most %d characters long, got %d", max, n)
+	}
+}
+
+// Range проверяет, что число лежит в отрезке [min, max]
+func (e *Errors) Range(field string, value, min, max int64) {
+	if value < min || value > max {
+		e.Add(field, "must be between %d and %d", min, max)
+	}
+}
+
+// URL проверяет абсолютный http(s) адрес. Пустое значение допустимо.
+func (e *Errors) URL(field, value string, maxLength int) {
+	if value == "" {
+		return
+	}
+	if len(value) > maxLength {
+		e.Add(field, "must be at most %d characters long", maxLength)
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		e.Add(field, "must be an absolute http or https URL")
+	}
+}
+
+// Tags проверяет список тегов: не больше maxCount, без пустых значений и дубликатов,
+// каждый не длиннее maxLength символов
+func (e *Errors) Tags(field string, tags []string, maxCount, maxLength int) {
+	if len(tags) > maxCount {
+		e.Add(field, "must contain at most %d tags, got %d", maxCount, len(tags))
+	}
+
+	seen := make(map[string]int, len(tags))
+	for i, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if !e.tag(fmt.Sprintf("%s[%d]", field, i), tag, maxLength) {
+			continue
+		}
+		if first, ok := seen[tag]; ok {
+			e.Add(fmt.Sprintf("%s[%d]", field, i), "duplicates %s[%d]", field, first)
+			continue
+		}
+		seen[tag] = i
+	}
+}
+
+// TagFilter проверяет теги фильтра так же, как Tags, но допускает повторы:
+// в фильтре они ничего не меняют, поэтому maxCount относится к различным тегам
+func (e *Errors) TagFilter(field string, tags []string, maxCount, maxLength int) {
+	seen := make(map[string]struct{}, len(tags))
+	for i, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if e.tag(fmt.Sprintf("%s[%d]", field, i), tag, maxLength) {
+			seen[tag] = struct{}{}
+		}
+	}
+	if len(seen) > maxCount {
+		e.Add(field, "must contain at most %d tags, got %d", maxCount, len(seen))
+	}
+}
+
+func (e *Errors) tag(name, tag string, maxLength int) bool {
+	if tag == "" {
+		e.Add(name, "must not be empty")
+		return false
+	}
+	if n := utf8.RuneCountInString(tag); n > maxLength {
+		e.Add(name, "must be at most %d characters long, got %d", maxLength, n)
+	}
+	return true
+}
+
+// Page проверяет параметры постраничного вывода. Нулевые значения означают значения по умолчанию.
+func (e *Errors) Page(page, limit int32, maxLimit int32) {
+	if page < 0 {
+		e.Add("page", "must not be negative")
+	}
+	if limit < 0 || limit > maxLimit {
+		e.Add("limit", "must be between 0 and %d", maxLimit)
+	}
+}
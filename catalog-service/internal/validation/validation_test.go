@@ -0,0 +1,114 @@
+package validation
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// violations возвращает нарушения в виде "поле: описание"
+func violations(t *testing.T, e *Errors) []string {
+	t.Helper()
+	err := e.Err()
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+	var got []string
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				got = append(got, v.Field+": "+v.Description)
+			}
+		}
+	}
+	return got
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		name  string
+		page  int32
+		limit int32
+		want  []string
+	}{
+		{"defaults", 0, 0, nil},
+		{"first page", 1, 1, nil},
+		{"max limit", 3, 100, nil},
+		{"negative page", -1, 10, []string{"page: must not be negative"}},
+		{"negative limit", 1, -1, []string{"limit: must be between 0 and 100"}},
+		{"limit too large", 1, 101, []string{"limit: must be between 0 and 100"}},
+		{"both", -1, 101, []string{"page: must not be negative", "limit: must be between 0 and 100"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var e Errors
+			e.Page(tt.page, tt.limit, 100)
+			if got := violations(t, &e); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldValidators(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(e *Errors)
+		want     []string
+	}{
+		{"required", func(e *Errors) { e.Required("title", "Dune") }, nil},
+		{"required blank", func(e *Errors) { e.Required("title", "  ") }, []string{"title: must not be empty"}},
+		{"max length counts runes", func(e *Errors) { e.MaxLength("title", "Дюна", 4) }, nil},
+		{"max length", func(e *Errors) { e.MaxLength("title", "Dune", 3) }, []string{"title: must be at most 3 characters long, got 4"}},
+		{"range", func(e *Errors) { e.Range("rating", 5, 1, 5) }, nil},
+		{"out of range", func(e *Errors) { e.Range("rating", 0, 1, 5) }, []string{"rating: must be between 1 and 5"}},
+		{"empty url", func(e *Errors) { e.URL("image_url", "", 10) }, nil},
+		{"url", func(e *Errors) { e.URL("image_url", "https://img.test/a.png", 100) }, nil},
+		{"relative url", func(e *Errors) { e.URL("image_url", "/a.png", 100) }, []string{"image_url: must be an absolute http or https URL"}},
+		{"javascript url", func(e *Errors) { e.URL("image_url", "javascript:alert(1)", 100) }, []string{"image_url: must be an absolute http or https URL"}},
+		{"long url", func(e *Errors) { e.URL("image_url", "https://img.test/a.png", 10) }, []string{"image_url: must be at most 10 characters long"}},
+		{"tags", func(e *Errors) { e.Tags("tags", []string{"a", "b"}, 2, 5) }, nil},
+		{
+			name:     "invalid tags",
+			validate: func(e *Errors) { e.Tags("tags", []string{"a", " ", "a ", "toolong"}, 3, 5) },
+			want: []string{
+				"tags: must contain at most 3 tags, got 4",
+				"tags[1]: must not be empty",
+				"tags[2]: duplicates tags[0]",
+				"tags[3]: must be at most 5 characters long, got 7",
+			},
+		},
+		{"tag filter allows duplicates", func(e *Errors) { e.TagFilter("tags", []string{"a", "a ", "b"}, 2, 5) }, nil},
+		{
+			name:     "tag filter counts distinct tags",
+			validate: func(e *Errors) { e.TagFilter("tags", []string{"a", "b", "c", ""}, 2, 5) },
+			want:     []string{"tags[3]: must not be empty", "tags: must contain at most 2 tags, got 3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var e Errors
+			tt.validate(&e)
+			if got := violations(t, &e); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrMessage(t *testing.T) {
+	var e Errors
+	e.Required("title", "")
+	e.Range("rating", 9, 1, 5)
+	// В сообщении статуса - первое нарушение, остальные только в деталях
+	if msg := status.Convert(e.Err()).Message(); !strings.HasPrefix(msg, "invalid request: title: must not be empty") {
+		t.Errorf("message = %q", msg)
+	}
+}
@@ -0,0 +1,380 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/itemchange"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+var (
+	subscribersGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "catalog_watch_subscribers",
+			Help: "Number of active WatchItems subscriptions",
+		},
+	)
+
+	droppedSubscribersTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "catalog_watch_dropped_subscribers_total",
+			Help: "Total number of WatchItems subscriptions dropped for falling behind",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(subscribersGauge)
+	prometheus.MustRegister(droppedSubscribersTotal)
+}
+
+const (
+	// pollBatchSize ограничивает число изменений, читаемых за один опрос
+	pollBatchSize = 500
+	// subscriberBuffer - сколько изменений может ждать отправки одному подписчику
+	subscriberBuffer = 256
+	cleanupInterval  = 10 * time.Minute
+)
+
+var (
+	// ErrSlowSubscriber - подписчик не успевал читать изменения и был отключён
+	ErrSlowSubscriber = errors.New("subscriber fell behind")
+	// ErrClosed - hub остановлен
+	ErrClosed = errors.New("watch hub is closed")
+)
+
+// Filter отбирает изменения для подписки. Пустой фильтр пропускает все изменения.
+type Filter struct {
+	// Tags - элемент должен иметь хотя бы один из тегов
+	Tags []string
+	IDs  []string
+}
+
+func (f Filter) Match(change *ent.ItemChange) bool {
+	if len(f.IDs) > 0 && !contains(f.IDs, change.ItemID) {
+		return false
+	}
+	if len(f.Tags) == 0 {
+		return true
+	}
+	for _, tag := range change.Tags {
+		if contains(f.Tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscription получает изменения с номером больше Start, подходящие под фильтр
+type Subscription struct {
+	filter Filter
+	start  int64
+	events chan *ent.ItemChange
+	err    error
+}
+
+// Start - последний номер, разосланный hub до подписки. Более ранние изменения читаются через Backfill.
+func (s *Subscription) Start() int64 {
+	return s.start
+}
+
+func (s *Subscription) Filter() Filter {
+	return s.filter
+}
+
+// Events закрывается, когда подписка отключена hub; причину возвращает Err
+func (s *Subscription) Events() <-chan *ent.ItemChange {
+	return s.events
+}
+
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Hub опрашивает ленту изменений и рассылает новые изменения подписчикам.
+// Один опрос на процесс вместо опроса на каждый поток; несколько реплик сервиса
+// читают общую таблицу, поэтому подписчик видит изменения, сделанные на любой реплике.
+type Hub struct {
+	client    *ent.Client
+	interval  time.Duration
+	retention time.Duration
+	logger    *zap.Logger
+
+	ready chan struct{}
+
+	// Номера в ленте выдаются при вставке, а видны после коммита, поэтому транзакция
+	// с меньшим номером может закоммититься позже. Пропуск в номерах ждёт, пока не завершатся
+	// все транзакции, начатые до его обнаружения: gapXmax - xmax снимка в этот момент,
+	// gapUpTo - первый видимый номер после пропуска. Номера откаченных транзакций
+	// не появятся никогда, их пропускаем, когда эти транзакции завершились.
+	// Поля меняет только цикл опроса.
+	gapXmax int64
+	gapUpTo int64
+	// snapshot возвращает xmin и xmax текущего снимка транзакций
+	snapshot func(ctx context.Context) (xmin, xmax int64, err error)
+
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	last   int64
+	closed bool
+}
+
+func New(client *ent.Client, interval, retention time.Duration, logger *zap.Logger) *Hub {
+	h := &Hub{
+		client:    client,
+		interval:  interval,
+		retention: retention,
+		logger:    logger,
+		ready:     make(chan struct{}),
+		subs:      make(map[*Subscription]struct{}),
+	}
+	h.snapshot = h.currentSnapshot
+	return h
+}
+
+// Run рассылает изменения каждые interval, пока не будет отменён ctx.
+// После остановки все подписки закрываются с ErrClosed.
+func (h *Hub) Run(ctx context.Context) {
+	defer h.close()
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	// Подписчики получают только изменения, сделанные после запуска hub
+	for {
+		last, err := h.latestSequence(ctx)
+		if err == nil {
+			h.last = last
+			close(h.ready)
+			break
+		}
+		if ctx.Err() == nil {
+			h.logger.Error("Failed to read change feed position", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+
+	var lastCleanup time.Time
+	for {
+		if err := h.poll(ctx); err != nil && ctx.Err() == nil {
+			h.logger.Error("Failed to poll change feed", zap.Error(err))
+		}
+		if time.Since(lastCleanup) >= cleanupInterval {
+			if err := h.cleanup(ctx); err != nil && ctx.Err() == nil {
+				h.logger.Error("Failed to clean up change feed", zap.Error(err))
+			} else {
+				lastCleanup = time.Now()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Hub) latestSequence(ctx context.Context) (int64, error) {
+	id, err := h.client.ItemChange.Query().
+		Order(ent.Desc(itemchange.FieldID)).
+		FirstID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	return id, err
+}
+
+func (h *Hub) poll(ctx context.Context) error {
+	// Снимок проверяется до чтения ленты: если транзакции, которые могли держать пропущенные номера,
+	// завершились, следующий запрос уже увидит всё, что они закоммитили
+	var settledUpTo int64
+	if h.gapXmax != 0 {
+		xmin, _, err := h.snapshot(ctx)
+		if err != nil {
+			return err
+		}
+		if xmin >= h.gapXmax {
+			settledUpTo = h.gapUpTo
+		}
+	}
+
+	changes, err := h.client.ItemChange.Query().
+		Where(itemchange.IDGT(h.last)).
+		Order(ent.Asc(itemchange.FieldID)).
+		Limit(pollBatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var gapAt int64
+	h.mu.Lock()
+	for _, change := range changes {
+		if change.ID != h.last+1 && change.ID > settledUpTo {
+			gapAt = change.ID
+			break
+		}
+		h.last = change.ID
+		h.broadcast(change)
+	}
+	h.mu.Unlock()
+
+	switch {
+	case gapAt == 0:
+		h.gapXmax, h.gapUpTo = 0, 0
+	case gapAt != h.gapUpTo:
+		_, xmax, err := h.snapshot(ctx)
+		if err != nil {
+			return err
+		}
+		h.gapXmax, h.gapUpTo = xmax, gapAt
+	}
+	return nil
+}
+
+// currentSnapshot читает снимок транзакций PostgreSQL: xmin - наименьший id транзакции,
+// которая ещё выполняется, xmax - id, с которого начнутся ещё не выданные транзакции
+func (h *Hub) currentSnapshot(ctx context.Context) (int64, int64, error) {
+	rows, err := h.client.QueryContext(ctx,
+		"SELECT pg_snapshot_xmin(s)::text::bigint, pg_snapshot_xmax(s)::text::bigint FROM pg_current_snapshot() AS s")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer rows.Close()
+
+	var xmin, xmax int64
+	if rows.Next() {
+		if err := rows.Scan(&xmin, &xmax); err != nil {
+			return 0, 0, err
+		}
+	}
+	return xmin, xmax, rows.Err()
+}
+
+// broadcast отправляет изменение подписчикам, не блокируясь на медленных. h.mu должен быть захвачен.
+func (h *Hub) broadcast(change *ent.ItemChange) {
+	for sub := range h.subs {
+		if !sub.filter.Match(change) {
+			continue
+		}
+		select {
+		case sub.events <- change:
+		default:
+			droppedSubscribersTotal.Inc()
+			h.remove(sub, ErrSlowSubscriber)
+		}
+	}
+}
+
+func (h *Hub) remove(sub *Subscription, err error) {
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	subscribersGauge.Dec()
+	sub.err = err
+	close(sub.events)
+}
+
+func (h *Hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	// Hub мог остановиться, не дочитав позицию ленты: ожидающие Subscribe должны получить ErrClosed
+	select {
+	case <-h.ready:
+	default:
+		close(h.ready)
+	}
+	for sub := range h.subs {
+		h.remove(sub, ErrClosed)
+	}
+}
+
+func (h *Hub) cleanup(ctx context.Context) error {
+	deleted, err := h.client.ItemChange.Delete().
+		Where(itemchange.CreatedAtLT(time.Now().Add(-h.retention))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		h.logger.Info("Cleaned up change feed", zap.Int("changes", deleted))
+	}
+	return nil
+}
+
+// Subscribe регистрирует подписку. Ждёт, пока hub прочитает текущую позицию ленты.
+func (h *Hub) Subscribe(ctx context.Context, filter Filter) (*Subscription, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-h.ready:
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil, ErrClosed
+	}
+
+	sub := &Subscription{
+		filter: filter,
+		start:  h.last,
+		events: make(chan *ent.ItemChange, subscriberBuffer),
+	}
+	h.subs[sub] = struct{}{}
+	subscribersGauge.Inc()
+	return sub, nil
+}
+
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(sub, ErrClosed)
+}
+
+// Retained сообщает, хранятся ли ещё все изменения с номером больше after
+func (h *Hub) Retained(ctx context.Context, after int64) (bool, error) {
+	oldest, err := h.client.ItemChange.Query().
+		Order(ent.Asc(itemchange.FieldID)).
+		FirstID(ctx)
+	if ent.IsNotFound(err) {
+		// Лента пуста: пропущенных изменений нет, только если after не отстаёт от hub
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		return after >= h.last, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return oldest <= after+1, nil
+}
+
+// Backfill возвращает до limit изменений с номерами в (after, upTo] по возрастанию
+func (h *Hub) Backfill(ctx context.Context, after, upTo int64, limit int) ([]*ent.ItemChange, error) {
+	return h.client.ItemChange.Query().
+		Where(itemchange.IDGT(after), itemchange.IDLTE(upTo)).
+		Order(ent.Asc(itemchange.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
@@ -0,0 +1,152 @@
+package watch
+
+import (
+	"context"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent"
+	"github.com/neokofg/go-pet-microservices/catalog-service/ent/enttest"
+	"go.uber.org/zap"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// pollStep добавляет изменения с номерами insert, задаёт снимок транзакций
+// и ожидает, что после опроса подписчик получил ровно номера want
+type pollStep struct {
+	insert     []int64
+	xmin, xmax int64
+	want       []int64
+}
+
+func TestHubGaps(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []pollStep
+	}{
+		{
+			name: "no gaps",
+			steps: []pollStep{
+				{insert: []int64{1, 2, 3}, xmin: 100, xmax: 100, want: []int64{1, 2, 3}},
+			},
+		},
+		{
+			name: "late commit fills the gap",
+			steps: []pollStep{
+				{insert: []int64{1, 3}, xmin: 100, xmax: 105, want: []int64{1}},
+				{xmin: 101, xmax: 105, want: nil},
+				{insert: []int64{2}, xmin: 101, xmax: 105, want: []int64{2, 3}},
+			},
+		},
+		{
+			name: "rolled back number is skipped once older transactions finish",
+			steps: []pollStep{
+				{insert: []int64{1, 3}, xmin: 100, xmax: 105, want: []int64{1}},
+				{xmin: 104, xmax: 110, want: nil},
+				{xmin: 105, xmax: 110, want: []int64{3}},
+			},
+		},
+		{
+			name: "transaction that commits while the gap settles is not lost",
+			steps: []pollStep{
+				{insert: []int64{1, 3}, xmin: 100, xmax: 105, want: []int64{1}},
+				{insert: []int64{2}, xmin: 105, xmax: 110, want: []int64{2, 3}},
+			},
+		},
+		{
+			name: "gap after a settled one waits for newer transactions",
+			steps: []pollStep{
+				{insert: []int64{1, 3, 5}, xmin: 100, xmax: 105, want: []int64{1}},
+				{xmin: 105, xmax: 110, want: []int64{3}},
+				{xmin: 109, xmax: 112, want: nil},
+				{xmin: 110, xmax: 112, want: []int64{5}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			name := strings.ReplaceAll(t.Name(), "/", "_")
+			client := enttest.Open(t, "sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+			defer client.Close()
+
+			h := New(client, time.Second, time.Hour, zap.NewNop())
+			var snapshot pollStep
+			h.snapshot = func(context.Context) (int64, int64, error) {
+				return snapshot.xmin, snapshot.xmax, nil
+			}
+			close(h.ready)
+			sub, err := h.Subscribe(ctx, Filter{})
+			if err != nil {
+				t.Fatalf("Subscribe: %v", err)
+			}
+
+			for i, step := range tt.steps {
+				for _, id := range step.insert {
+					insertChange(t, client, id)
+				}
+				snapshot = step
+				if err := h.poll(ctx); err != nil {
+					t.Fatalf("step %d: poll: %v", i, err)
+				}
+				if got := received(sub); !reflect.DeepEqual(got, step.want) {
+					t.Fatalf("step %d: received %v, want %v", i, got, step.want)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	change := &ent.ItemChange{ItemID: "item-1", Tags: []string{"books", "sale"}}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty", Filter{}, true},
+		{"one of tags", Filter{Tags: []string{"films", "sale"}}, true},
+		{"other tags", Filter{Tags: []string{"films"}}, false},
+		{"id", Filter{IDs: []string{"item-2", "item-1"}}, true},
+		{"other id", Filter{IDs: []string{"item-2"}}, false},
+		{"id and tag", Filter{IDs: []string{"item-1"}, Tags: []string{"books"}}, true},
+		{"id and other tag", Filter{IDs: []string{"item-1"}, Tags: []string{"films"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(change); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func insertChange(t *testing.T, client *ent.Client, id int64) {
+	t.Helper()
+	err := client.ItemChange.Create().
+		SetID(id).
+		SetEventID("event-" + strconv.FormatInt(id, 10)).
+		SetEventType("item.updated").
+		SetItemID("item-1").
+		SetPayload([]byte("{}")).
+		Exec(context.Background())
+	if err != nil {
+		t.Fatalf("insert change %d: %v", id, err)
+	}
+}
+
+// received забирает из подписки всё, что hub успел в неё отправить
+func received(sub *Subscription) []int64 {
+	var ids []int64
+	for {
+		select {
+		case change := <-sub.Events():
+			ids = append(ids, change.ID)
+		default:
+			return ids
+		}
+	}
+}
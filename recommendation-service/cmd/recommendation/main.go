@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	catalogpb "github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/recommendation-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/recommendation-service/internal/loader"
+	"github.com/neokofg/go-pet-microservices/recommendation-service/internal/recommender"
+	"github.com/neokofg/go-pet-microservices/recommendation-service/internal/service"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+func main() {
+	logger, _ := zap.NewProduction()
+	defer logger.Sync()
+
+	refreshInterval := time.Minute
+	if value := os.Getenv("REFRESH_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil {
+			logger.Fatal("Invalid REFRESH_INTERVAL", zap.Error(err))
+		}
+		refreshInterval = interval
+	}
+
+	// Вес априорного среднего в байесовском рейтинге, см. recommender.New
+	priorWeight := 10.0
+	if value := os.Getenv("RATING_PRIOR_WEIGHT"); value != "" {
+		weight, err := strconv.ParseFloat(value, 64)
+		if err != nil || weight < 0 {
+			logger.Fatal("Invalid RATING_PRIOR_WEIGHT", zap.String("value", value))
+		}
+		priorWeight = weight
+	}
+
+	catalogConn, err := grpc.NewClient(os.Getenv("CATALOG_SERVICE_ADDR"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		logger.Fatal("Failed to connect to catalog service", zap.Error(err))
+	}
+	defer catalogConn.Close()
+
+	holder := recommender.NewHolder()
+
+	ctx, stopLoader := context.WithCancel(context.Background())
+	defer stopLoader()
+
+	ldr := loader.New(catalogpb.NewCatalogServiceClient(catalogConn), holder, refreshInterval, priorWeight, logger)
+	go ldr.Run(ctx)
+
+	recommendationService := service.NewRecommendationService(holder, logger)
+
+	grpcServer := grpc.NewServer()
+	proto.RegisterRecommendationServiceServer(grpcServer, recommendationService)
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	grpcAddr := fmt.Sprintf(":%s", os.Getenv("GRPC_PORT"))
+	lis, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		logger.Fatal("Failed to listen", zap.Error(err))
+	}
+
+	go func() {
+		logger.Info("Starting gRPC server", zap.String("addr", grpcAddr))
+		if err := grpcServer.Serve(lis); err != nil {
+			logger.Fatal("Failed to serve gRPC", zap.Error(err))
+		}
+	}()
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+
+	router.GET("/health", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok", "items": holder.Load().Len()})
+	})
+
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	httpServer := &http.Server{
+		Addr:    fmt.Sprintf(":%s", os.Getenv("HTTP_PORT")),
+		Handler: router,
+	}
+
+	go func() {
+		logger.Info("Starting HTTP server", zap.String("addr", httpServer.Addr))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	logger.Info("Shutting down servers...")
+	stopLoader()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Fatal("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	grpcServer.GracefulStop()
+
+	logger.Info("Servers exited properly")
+}
@@ -0,0 +1,136 @@
+package loader
+
+import (
+	"context"
+	catalogpb "github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/recommendation-service/internal/recommender"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"time"
+)
+
+const pageSize = 500
+
+var (
+	modelItems = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "recommendation_model_items",
+			Help: "Number of items in the current recommendation model",
+		},
+	)
+
+	refreshDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "recommendation_refresh_duration_seconds",
+			Help:    "Duration of a full model refresh from the catalog service",
+			Buckets: prometheus.DefBuckets,
+		},
+	)
+
+	refreshErrorsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "recommendation_refresh_errors_total",
+			Help: "Total number of failed model refresh attempts",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(modelItems)
+	prometheus.MustRegister(refreshDuration)
+	prometheus.MustRegister(refreshErrorsTotal)
+}
+
+// Loader периодически перечитывает каталог и перестраивает модель рекомендаций
+type Loader struct {
+	catalog     catalogpb.CatalogServiceClient
+	holder      *recommender.Holder
+	interval    time.Duration
+	priorWeight float64
+	logger      *zap.Logger
+}
+
+func New(
+	catalog catalogpb.CatalogServiceClient,
+	holder *recommender.Holder,
+	interval time.Duration,
+	priorWeight float64,
+	logger *zap.Logger,
+) *Loader {
+	return &Loader{
+		catalog:     catalog,
+		holder:      holder,
+		interval:    interval,
+		priorWeight: priorWeight,
+		logger:      logger,
+	}
+}
+
+// Run обновляет модель сразу и затем каждые interval, пока не будет отменён ctx
+func (l *Loader) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.Refresh(ctx); err != nil && ctx.Err() == nil {
+			refreshErrorsTotal.Inc()
+			l.logger.Error("Failed to refresh recommendation model", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *Loader) Refresh(ctx context.Context) error {
+	start := time.Now()
+
+	var items []recommender.Item
+	cursor := ""
+	for {
+		resp, err := l.fetchPage(ctx, cursor)
+		if err != nil {
+			return err
+		}
+		for _, itm := range resp.Items {
+			items = append(items, recommender.Item{
+				ID:          itm.Id,
+				Title:       itm.Title,
+				Description: itm.Description,
+				Tags:        itm.Tags,
+				ImageURL:    itm.ImageUrl,
+				Rating:      itm.Rating,
+				ReviewCount: int(itm.ReviewCount),
+			})
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+	}
+
+	l.holder.Store(recommender.New(items, l.priorWeight))
+
+	modelItems.Set(float64(len(items)))
+	refreshDuration.Observe(time.Since(start).Seconds())
+	l.logger.Info("Recommendation model refreshed",
+		zap.Int("items", len(items)),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return nil
+}
+
+func (l *Loader) fetchPage(ctx context.Context, cursor string) (*catalogpb.GetItemsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return l.catalog.GetItems(ctx, &catalogpb.GetItemsRequest{
+		Page:      1,
+		Limit:     pageSize,
+		Cursor:    cursor,
+		SkipTotal: true,
+	})
+}
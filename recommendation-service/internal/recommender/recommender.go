@@ -0,0 +1,174 @@
+package recommender
+
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// Доли сходства по тегам и по тексту в итоговой оценке похожести
+const (
+	tagSimilarityWeight     = 0.5
+	contentSimilarityWeight = 0.5
+)
+
+// Item - элемент каталога в том виде, в котором он нужен для рекомендаций
+type Item struct {
+	ID          string
+	Title       string
+	Description string
+	Tags        []string
+	ImageURL    string
+	Rating      float64
+	ReviewCount int
+}
+
+type Scored struct {
+	Item  Item
+	Score float64
+}
+
+// Model - неизменяемый снимок каталога с предрасчитанными векторами и рейтингами.
+// После построения безопасна для конкурентного чтения.
+type Model struct {
+	items   []Item
+	byID    map[string]int
+	tags    []map[string]struct{}
+	vectors []vector
+	// bayesian - байесовское среднее рейтинга для каждого элемента
+	bayesian []float64
+}
+
+// New строит модель по снимку каталога.
+// priorWeight - число "виртуальных" отзывов со средним рейтингом каталога, которое добавляется
+// к отзывам каждого элемента. Чем оно больше, тем сильнее элементы с малым числом отзывов
+// притягиваются к среднему.
+func New(items []Item, priorWeight float64) *Model {
+	m := &Model{
+		items:    items,
+		byID:     make(map[string]int, len(items)),
+		tags:     make([]map[string]struct{}, len(items)),
+		vectors:  buildVectors(items),
+		bayesian: make([]float64, len(items)),
+	}
+
+	var ratingSum float64
+	var reviewSum int
+	for i, itm := range items {
+		m.byID[itm.ID] = i
+		m.tags[i] = make(map[string]struct{}, len(itm.Tags))
+		for _, tag := range itm.Tags {
+			m.tags[i][strings.ToLower(tag)] = struct{}{}
+		}
+		ratingSum += itm.Rating * float64(itm.ReviewCount)
+		reviewSum += itm.ReviewCount
+	}
+
+	// Средний рейтинг по всем отзывам каталога
+	var mean float64
+	if reviewSum > 0 {
+		mean = ratingSum / float64(reviewSum)
+	}
+	for i, itm := range items {
+		votes := float64(itm.ReviewCount)
+		if votes+priorWeight == 0 {
+			continue
+		}
+		m.bayesian[i] = (votes*itm.Rating + priorWeight*mean) / (votes + priorWeight)
+	}
+
+	return m
+}
+
+// Len возвращает количество элементов в модели
+func (m *Model) Len() int {
+	return len(m.items)
+}
+
+// Similar возвращает до limit элементов, похожих на элемент id по тегам и тексту.
+// Второе значение равно false, если элемента нет в снимке каталога.
+func (m *Model) Similar(id string, limit int) ([]Scored, bool) {
+	source, ok := m.byID[id]
+	if !ok {
+		return nil, false
+	}
+
+	// Полный перебор: для каталога в десятки тысяч элементов это дешевле, чем поддерживать отдельный индекс
+	result := make([]Scored, 0, min(limit, len(m.items)))
+	for i := range m.items {
+		if i == source {
+			continue
+		}
+		score := tagSimilarityWeight*jaccard(m.tags[source], m.tags[i]) +
+			contentSimilarityWeight*cosine(m.vectors[source], m.vectors[i])
+		if score <= 0 {
+			continue
+		}
+		result = append(result, Scored{Item: m.items[i], Score: score})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].Item.ID < result[j].Item.ID
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, true
+}
+
+// TopRated возвращает до limit элементов с наибольшим байесовским рейтингом.
+// Если заданы tags, учитываются только элементы со всеми этими тегами.
+func (m *Model) TopRated(limit int, tags []string) []Scored {
+	result := make([]Scored, 0, min(limit, len(m.items)))
+	for i, itm := range m.items {
+		if !m.hasTags(i, tags) {
+			continue
+		}
+		result = append(result, Scored{Item: itm, Score: m.bayesian[i]})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		if result[i].Item.ReviewCount != result[j].Item.ReviewCount {
+			return result[i].Item.ReviewCount > result[j].Item.ReviewCount
+		}
+		return result[i].Item.ID < result[j].Item.ID
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+func (m *Model) hasTags(i int, tags []string) bool {
+	for _, tag := range tags {
+		if _, ok := m.tags[i][strings.ToLower(tag)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Holder хранит текущую версию модели и позволяет атомарно подменить её после обновления
+type Holder struct {
+	current atomic.Pointer[Model]
+}
+
+func NewHolder() *Holder {
+	h := &Holder{}
+	h.current.Store(New(nil, 0))
+	return h
+}
+
+func (h *Holder) Load() *Model {
+	return h.current.Load()
+}
+
+func (h *Holder) Store(m *Model) {
+	h.current.Store(m)
+}
@@ -0,0 +1,158 @@
+package recommender
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func scoredIDs(scored []Scored) []string {
+	ids := make([]string, len(scored))
+	for i, s := range scored {
+		ids[i] = s.Item.ID
+	}
+	return ids
+}
+
+func TestBayesianRating(t *testing.T) {
+	items := []Item{
+		{ID: "one-review", Rating: 5, ReviewCount: 1},
+		{ID: "popular", Rating: 4.5, ReviewCount: 100},
+		{ID: "average", Rating: 3, ReviewCount: 10},
+		{ID: "unrated"},
+	}
+	// Средний рейтинг по всем отзывам: (5*1 + 4.5*100 + 3*10) / 111
+	mean := 485.0 / 111
+
+	tests := []struct {
+		name        string
+		priorWeight float64
+		want        map[string]float64
+		wantOrder   []string
+	}{
+		{
+			name:        "no prior keeps raw ratings",
+			priorWeight: 0,
+			want:        map[string]float64{"one-review": 5, "popular": 4.5, "average": 3, "unrated": 0},
+			wantOrder:   []string{"one-review", "popular", "average", "unrated"},
+		},
+		{
+			name:        "prior pulls items with few reviews to the mean",
+			priorWeight: 10,
+			want: map[string]float64{
+				"one-review": (5 + 10*mean) / 11,
+				"popular":    (450 + 10*mean) / 110,
+				"average":    (30 + 10*mean) / 20,
+				"unrated":    mean,
+			},
+			wantOrder: []string{"popular", "one-review", "unrated", "average"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			top := New(items, tt.priorWeight).TopRated(10, nil)
+			if got := scoredIDs(top); !reflect.DeepEqual(got, tt.wantOrder) {
+				t.Errorf("TopRated order = %v, want %v", got, tt.wantOrder)
+			}
+			for _, s := range top {
+				if math.Abs(s.Score-tt.want[s.Item.ID]) > 1e-9 {
+					t.Errorf("score of %s = %v, want %v", s.Item.ID, s.Score, tt.want[s.Item.ID])
+				}
+			}
+		})
+	}
+}
+
+func TestTopRated(t *testing.T) {
+	m := New([]Item{
+		{ID: "a", Rating: 4, ReviewCount: 10, Tags: []string{"Sci-Fi", "classic"}},
+		{ID: "b", Rating: 4, ReviewCount: 20, Tags: []string{"sci-fi"}},
+		{ID: "c", Rating: 5, ReviewCount: 20, Tags: []string{"classic"}},
+		{ID: "d", Rating: 4, ReviewCount: 10},
+	}, 0)
+
+	tests := []struct {
+		name  string
+		limit int
+		tags  []string
+		want  []string
+	}{
+		{"equal ratings ordered by reviews, then id", 10, nil, []string{"c", "b", "a", "d"}},
+		{"limit", 2, nil, []string{"c", "b"}},
+		{"tag filter is case insensitive", 10, []string{"SCI-FI"}, []string{"b", "a"}},
+		{"all tags are required", 10, []string{"sci-fi", "classic"}, []string{"a"}},
+		{"unknown tag", 10, []string{"western"}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scoredIDs(m.TopRated(tt.limit, tt.tags)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopRated(%d, %v) = %v, want %v", tt.limit, tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimilar(t *testing.T) {
+	m := New([]Item{
+		{ID: "dune", Title: "Dune", Description: "desert planet spice", Tags: []string{"sci-fi", "classic"}},
+		{ID: "dune-messiah", Title: "Dune Messiah", Description: "desert planet", Tags: []string{"sci-fi", "classic"}},
+		{ID: "hyperion", Title: "Hyperion", Description: "pilgrims", Tags: []string{"sci-fi"}},
+		{ID: "emma", Title: "Emma", Description: "matchmaking", Tags: []string{"romance"}},
+	}, 0)
+
+	tests := []struct {
+		name   string
+		id     string
+		limit  int
+		want   []string
+		wantOK bool
+	}{
+		{"tags and text", "dune", 10, []string{"dune-messiah", "hyperion"}, true},
+		{"limit", "dune", 1, []string{"dune-messiah"}, true},
+		{"nothing in common", "emma", 10, []string{}, true},
+		{"unknown item", "missing", 10, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			similar, ok := m.Similar(tt.id, tt.limit)
+			if ok != tt.wantOK {
+				t.Fatalf("Similar(%q) ok = %v, want %v", tt.id, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got := scoredIDs(similar); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Similar(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	set := func(tags ...string) map[string]struct{} {
+		s := make(map[string]struct{}, len(tags))
+		for _, tag := range tags {
+			s[tag] = struct{}{}
+		}
+		return s
+	}
+
+	tests := []struct {
+		name string
+		a, b map[string]struct{}
+		want float64
+	}{
+		{"same", set("a", "b"), set("a", "b"), 1},
+		{"half", set("a", "b"), set("a"), 0.5},
+		{"one of three", set("a", "b"), set("b", "c"), 1.0 / 3},
+		{"disjoint", set("a"), set("b"), 0},
+		{"empty", set(), set("a"), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jaccard(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("jaccard() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
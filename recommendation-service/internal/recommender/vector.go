@@ -0,0 +1,82 @@
+package recommender
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// vector - разреженный вектор TF-IDF, нормированный по длине
+type vector map[string]float64
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// buildVectors строит TF-IDF векторы по заголовку и описанию каждого элемента.
+// Заголовок учитывается с двойным весом, так как он точнее описывает элемент.
+func buildVectors(items []Item) []vector {
+	freqs := make([]map[string]float64, len(items))
+	df := make(map[string]int)
+	for i, itm := range items {
+		freqs[i] = make(map[string]float64)
+		for _, term := range tokenize(itm.Title) {
+			freqs[i][term] += 2
+		}
+		for _, term := range tokenize(itm.Description) {
+			freqs[i][term]++
+		}
+		for term := range freqs[i] {
+			df[term]++
+		}
+	}
+
+	n := float64(len(items))
+	vectors := make([]vector, len(items))
+	for i, tf := range freqs {
+		vec := make(vector, len(tf))
+		var norm float64
+		for term, count := range tf {
+			weight := (1 + math.Log(count)) * math.Log(1+n/float64(df[term]))
+			vec[term] = weight
+			norm += weight * weight
+		}
+		norm = math.Sqrt(norm)
+		for term := range vec {
+			vec[term] /= norm
+		}
+		vectors[i] = vec
+	}
+	return vectors
+}
+
+// cosine считает косинусное сходство двух нормированных векторов
+func cosine(a, b vector) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var dot float64
+	for term, weight := range a {
+		dot += weight * b[term]
+	}
+	return dot
+}
+
+// jaccard считает долю общих тегов среди всех тегов двух элементов
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	var common int
+	for tag := range a {
+		if _, ok := b[tag]; ok {
+			common++
+		}
+	}
+	return float64(common) / float64(len(a)+len(b)-common)
+}
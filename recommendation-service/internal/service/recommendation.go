@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/recommendation-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/recommendation-service/internal/recommender"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RecommendationService struct {
+	proto.UnimplementedRecommendationServiceServer
+	holder *recommender.Holder
+	logger *zap.Logger
+}
+
+func NewRecommendationService(holder *recommender.Holder, logger *zap.Logger) *RecommendationService {
+	return &RecommendationService{
+		holder: holder,
+		logger: logger,
+	}
+}
+
+func (s *RecommendationService) GetSimilarItems(ctx context.Context, req *proto.GetSimilarItemsRequest) (*proto.RecommendationsResponse, error) {
+	if req.ItemId == "" {
+		return nil, status.Error(codes.InvalidArgument, "item_id is required")
+	}
+
+	items, ok := s.holder.Load().Similar(req.ItemId, limitOrDefault(req.Limit))
+	if !ok {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+
+	return toProtoResponse(items), nil
+}
+
+func (s *RecommendationService) GetTopRated(ctx context.Context, req *proto.GetTopRatedRequest) (*proto.RecommendationsResponse, error) {
+	items := s.holder.Load().TopRated(limitOrDefault(req.Limit), req.Tags)
+	return toProtoResponse(items), nil
+}
+
+// maxLimit ограничивает размер ответа: лимит приходит от клиента как есть
+const maxLimit = 100
+
+func limitOrDefault(limit int32) int {
+	if limit <= 0 {
+		return 10
+	}
+	return int(min(limit, maxLimit))
+}
+
+func toProtoResponse(items []recommender.Scored) *proto.RecommendationsResponse {
+	protoItems := make([]*proto.RecommendedItem, len(items))
+	for i, scored := range items {
+		protoItems[i] = &proto.RecommendedItem{
+			Id:          scored.Item.ID,
+			Title:       scored.Item.Title,
+			Description: scored.Item.Description,
+			Tags:        scored.Item.Tags,
+			ImageUrl:    scored.Item.ImageURL,
+			Rating:      scored.Item.Rating,
+			ReviewCount: int32(scored.Item.ReviewCount),
+			Score:       scored.Score,
+		}
+	}
+	return &proto.RecommendationsResponse{
+		Items: protoItems,
+	}
+}
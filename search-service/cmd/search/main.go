@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	catalogpb "github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/search-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/search-service/internal/index"
+	"github.com/neokofg/go-pet-microservices/search-service/internal/indexer"
+	"github.com/neokofg/go-pet-microservices/search-service/internal/service"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	logger, _ := zap.NewProduction()
+	defer logger.Sync()
+
+	reindexInterval := time.Minute
+	if value := os.Getenv("REINDEX_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			// time.NewTicker паникует на неположительном интервале
+			logger.Fatal("Invalid REINDEX_INTERVAL", zap.String("value", value))
+		}
+		reindexInterval = interval
+	}
+
+	catalogConn, err := grpc.NewClient(os.Getenv("CATALOG_SERVICE_ADDR"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		logger.Fatal("Failed to connect to catalog service", zap.Error(err))
+	}
+	defer catalogConn.Close()
+
+	holder := index.NewHolder()
+
+	ctx, stopIndexer := context.WithCancel(context.Background())
+	defer stopIndexer()
+
+	idx := indexer.New(catalogpb.NewCatalogServiceClient(catalogConn), holder, reindexInterval, logger)
+	go idx.Run(ctx)
+
+	searchService := service.NewSearchService(holder, logger)
+
+	grpcServer := grpc.NewServer()
+	proto.RegisterSearchServiceServer(grpcServer, searchService)
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	grpcAddr := fmt.Sprintf(":%s", os.Getenv("GRPC_PORT"))
+	lis, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		logger.Fatal("Failed to listen", zap.Error(err))
+	}
+
+	go func() {
+		logger.Info("Starting gRPC server", zap.String("addr", grpcAddr))
+		if err := grpcServer.Serve(lis); err != nil {
+			logger.Fatal("Failed to serve gRPC", zap.Error(err))
+		}
+	}()
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+
+	router.GET("/health", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok", "documents": holder.Load().Len()})
+	})
+
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	httpServer := &http.Server{
+		Addr:    fmt.Sprintf(":%s", os.Getenv("HTTP_PORT")),
+		Handler: router,
+	}
+
+	go func() {
+		logger.Info("Starting HTTP server", zap.String("addr", httpServer.Addr))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Fatal("Failed to start HTTP server", zap.Error(err))
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	logger.Info("Shutting down servers...")
+	stopIndexer()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Fatal("HTTP server forced to shutdown", zap.Error(err))
+	}
+
+	grpcServer.GracefulStop()
+
+	logger.Info("Servers exited properly")
+}
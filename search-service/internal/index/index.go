@@ -0,0 +1,293 @@
+package index
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// Параметры BM25
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Веса полей: совпадение в заголовке важнее, чем в тегах, а в тегах важнее, чем в описании
+const (
+	titleBoost       = 3
+	tagBoost         = 2
+	descriptionBoost = 1
+)
+
+// Множители для терминов, найденных не по точному совпадению
+const (
+	prefixWeight = 0.7
+	fuzzyWeight  = 0.5
+	maxExpansion = 50
+)
+
+// Document - элемент каталога в том виде, в котором он хранится в индексе
+type Document struct {
+	ID          string
+	Title       string
+	Description string
+	Tags        []string
+	ImageURL    string
+	Rating      float64
+	ReviewCount int
+}
+
+type posting struct {
+	doc int
+	tf  float64
+}
+
+// Index - неизменяемый инвертированный индекс. После построения безопасен для конкурентного чтения.
+type Index struct {
+	docs      []Document
+	docTags   []map[string]struct{}
+	docLen    []float64
+	avgDocLen float64
+	postings  map[string][]posting
+	// terms - отсортированный словарь для поиска по префиксу
+	terms []string
+}
+
+func New(docs []Document) *Index {
+	ix := &Index{
+		docs:     docs,
+		docTags:  make([]map[string]struct{}, len(docs)),
+		docLen:   make([]float64, len(docs)),
+		postings: make(map[string][]posting),
+	}
+
+	var totalLen float64
+	for i, doc := range docs {
+		freqs := make(map[string]float64)
+		for _, term := range Tokenize(doc.Title) {
+			freqs[term] += titleBoost
+		}
+		for _, term := range Tokenize(doc.Description) {
+			freqs[term] += descriptionBoost
+		}
+		ix.docTags[i] = make(map[string]struct{}, len(doc.Tags))
+		for _, tag := range doc.Tags {
+			ix.docTags[i][strings.ToLower(tag)] = struct{}{}
+			for _, term := range Tokenize(tag) {
+				freqs[term] += tagBoost
+			}
+		}
+
+		for term, tf := range freqs {
+			ix.postings[term] = append(ix.postings[term], posting{doc: i, tf: tf})
+			ix.docLen[i] += tf
+		}
+		totalLen += ix.docLen[i]
+	}
+	if len(docs) > 0 {
+		ix.avgDocLen = totalLen / float64(len(docs))
+	}
+
+	ix.terms = make([]string, 0, len(ix.postings))
+	for term := range ix.postings {
+		ix.terms = append(ix.terms, term)
+	}
+	sort.Strings(ix.terms)
+
+	return ix
+}
+
+// Len возвращает количество документов в индексе
+func (ix *Index) Len() int {
+	return len(ix.docs)
+}
+
+type Query struct {
+	Text string
+	// Tags - теги, которые должны быть у каждого найденного документа
+	Tags []string
+	// Exact отключает нечёткое сравнение терминов
+	Exact  bool
+	Offset int
+	Limit  int
+}
+
+type Hit struct {
+	Document Document
+	Score    float64
+}
+
+type Facet struct {
+	Tag   string
+	Count int
+}
+
+type Result struct {
+	Hits   []Hit
+	Total  int
+	Facets []Facet
+}
+
+func (ix *Index) Search(q Query) Result {
+	terms := Tokenize(q.Text)
+	if len(terms) == 0 || len(ix.docs) == 0 {
+		return Result{}
+	}
+
+	scores := make(map[int]float64)
+	for i, term := range terms {
+		// Последний термин может быть недописан, поэтому для него ищем и по префиксу
+		expansions := ix.expand(term, i == len(terms)-1, !q.Exact)
+
+		// Для каждого документа учитываем лучшее из расширений термина, а не их сумму
+		best := make(map[int]float64)
+		for expanded, weight := range expansions {
+			postings := ix.postings[expanded]
+			idf := ix.idf(len(postings))
+			for _, p := range postings {
+				score := weight * idf * ix.saturate(p.tf, ix.docLen[p.doc])
+				if score > best[p.doc] {
+					best[p.doc] = score
+				}
+			}
+		}
+		for doc, score := range best {
+			scores[doc] += score
+		}
+	}
+
+	filterTags := make([]string, 0, len(q.Tags))
+	for _, tag := range q.Tags {
+		filterTags = append(filterTags, strings.ToLower(tag))
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	facetCounts := make(map[string]int)
+	for doc, score := range scores {
+		if !ix.hasTags(doc, filterTags) {
+			continue
+		}
+		hits = append(hits, Hit{Document: ix.docs[doc], Score: score})
+		for tag := range ix.docTags[doc] {
+			facetCounts[tag]++
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if hits[i].Document.Rating != hits[j].Document.Rating {
+			return hits[i].Document.Rating > hits[j].Document.Rating
+		}
+		return hits[i].Document.ID < hits[j].Document.ID
+	})
+
+	result := Result{
+		Total:  len(hits),
+		Facets: sortFacets(facetCounts),
+	}
+	if q.Offset < len(hits) {
+		end := len(hits)
+		if q.Limit > 0 && q.Offset+q.Limit < end {
+			end = q.Offset + q.Limit
+		}
+		result.Hits = hits[q.Offset:end]
+	}
+	return result
+}
+
+// expand возвращает термины словаря, которые считаются совпадением для term, с их весами
+func (ix *Index) expand(term string, prefix, fuzzy bool) map[string]float64 {
+	expansions := make(map[string]float64)
+	if _, ok := ix.postings[term]; ok {
+		expansions[term] = 1
+	}
+
+	if prefix {
+		start := sort.SearchStrings(ix.terms, term)
+		for i := start; i < len(ix.terms) && len(expansions) < maxExpansion; i++ {
+			if !strings.HasPrefix(ix.terms[i], term) {
+				break
+			}
+			if ix.terms[i] != term {
+				expansions[ix.terms[i]] = prefixWeight
+			}
+		}
+	}
+
+	// Опечатки ищем, только если точного совпадения нет
+	if fuzzy && len(ix.postings[term]) == 0 {
+		runes := []rune(term)
+		edits := maxEdits(runes)
+		if edits == 0 {
+			return expansions
+		}
+		for _, candidate := range ix.terms {
+			if len(expansions) >= maxExpansion {
+				break
+			}
+			if _, ok := expansions[candidate]; ok {
+				continue
+			}
+			if editDistance(runes, []rune(candidate), edits) <= edits {
+				expansions[candidate] = fuzzyWeight
+			}
+		}
+	}
+
+	return expansions
+}
+
+func (ix *Index) idf(df int) float64 {
+	n := float64(len(ix.docs))
+	return math.Log(1 + (n-float64(df)+0.5)/(float64(df)+0.5))
+}
+
+func (ix *Index) saturate(tf, docLen float64) float64 {
+	norm := 1 - b + b*docLen/ix.avgDocLen
+	return tf * (k1 + 1) / (tf + k1*norm)
+}
+
+func (ix *Index) hasTags(doc int, tags []string) bool {
+	for _, tag := range tags {
+		if _, ok := ix.docTags[doc][tag]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func sortFacets(counts map[string]int) []Facet {
+	facets := make([]Facet, 0, len(counts))
+	for tag, count := range counts {
+		facets = append(facets, Facet{Tag: tag, Count: count})
+	}
+	sort.Slice(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		return facets[i].Tag < facets[j].Tag
+	})
+	return facets
+}
+
+// Holder хранит текущую версию индекса и позволяет атомарно подменить её после переиндексации
+type Holder struct {
+	current atomic.Pointer[Index]
+}
+
+func NewHolder() *Holder {
+	h := &Holder{}
+	h.current.Store(New(nil))
+	return h
+}
+
+func (h *Holder) Load() *Index {
+	return h.current.Load()
+}
+
+func (h *Holder) Store(ix *Index) {
+	h.current.Store(ix)
+}
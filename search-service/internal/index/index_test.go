@@ -0,0 +1,182 @@
+package index
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func hitIDs(result Result) []string {
+	ids := make([]string, len(result.Hits))
+	for i, hit := range result.Hits {
+		ids[i] = hit.Document.ID
+	}
+	return ids
+}
+
+func TestSearchRanking(t *testing.T) {
+	tests := []struct {
+		name  string
+		docs  []Document
+		query Query
+		want  []string
+	}{
+		{
+			name: "title outweighs tags and tags outweigh description",
+			docs: []Document{
+				{ID: "description", Title: "Book", Description: "dune"},
+				{ID: "tag", Title: "Book", Tags: []string{"dune"}},
+				{ID: "title", Title: "Dune"},
+			},
+			query: Query{Text: "dune", Exact: true},
+			want:  []string{"title", "tag", "description"},
+		},
+		{
+			name: "shorter document wins at equal term frequency",
+			docs: []Document{
+				{ID: "long", Title: "Dune", Description: "a long story about sand worms spice and politics"},
+				{ID: "short", Title: "Dune"},
+			},
+			query: Query{Text: "dune", Exact: true},
+			want:  []string{"short", "long"},
+		},
+		{
+			name: "rare term outweighs common term",
+			docs: []Document{
+				{ID: "common", Title: "space"},
+				{ID: "rare", Title: "opera"},
+				{ID: "other-1", Title: "space"},
+				{ID: "other-2", Title: "space"},
+			},
+			query: Query{Text: "space opera", Exact: true},
+			want:  []string{"rare", "common", "other-1", "other-2"},
+		},
+		{
+			name: "every query term adds to the score",
+			docs: []Document{
+				{ID: "one", Title: "space"},
+				{ID: "both", Title: "space opera"},
+				{ID: "none", Title: "western"},
+			},
+			query: Query{Text: "space opera", Exact: true},
+			want:  []string{"both", "one"},
+		},
+		{
+			name: "equal scores ordered by rating, then id",
+			docs: []Document{
+				{ID: "b", Title: "Dune", Rating: 4},
+				{ID: "c", Title: "Dune", Rating: 5},
+				{ID: "a", Title: "Dune", Rating: 4},
+			},
+			query: Query{Text: "dune"},
+			want:  []string{"c", "a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hitIDs(New(tt.docs).Search(tt.query))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query.Text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchMatching(t *testing.T) {
+	docs := []Document{
+		{ID: "dune", Title: "Dune", Tags: []string{"Sci-Fi", "classic"}},
+		{ID: "dunes", Title: "Dunes of Arrakis", Tags: []string{"sci-fi"}},
+		{ID: "hyperion", Title: "Hyperion", Tags: []string{"sci-fi", "classic"}},
+		{ID: "emma", Title: "Emma", Tags: []string{"classic"}},
+	}
+	ix := New(docs)
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []string
+	}{
+		{"exact term ranks above prefix", Query{Text: "dune"}, []string{"dune", "dunes"}},
+		{"prefix of the last term", Query{Text: "hyper"}, []string{"hyperion"}},
+		{"prefix only for the last term", Query{Text: "hyper dune"}, []string{"dune", "dunes"}},
+		{"typo", Query{Text: "hyperoin"}, []string{"hyperion"}},
+		{"transposition", Query{Text: "dnue"}, []string{"dune"}},
+		{"exact disables typos", Query{Text: "hyperoin", Exact: true}, []string{}},
+		{"short terms need an exact match", Query{Text: "emx"}, []string{}},
+		{"tag filter", Query{Text: "classic", Tags: []string{"SCI-FI"}}, []string{"dune", "hyperion"}},
+		{"all tags are required", Query{Text: "dune", Tags: []string{"sci-fi", "classic"}}, []string{"dune"}},
+		{"empty query", Query{Text: " ,. "}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hitIDs(ix.Search(tt.query))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%+v) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchPagingAndFacets(t *testing.T) {
+	docs := []Document{
+		{ID: "a", Title: "Dune", Tags: []string{"sci-fi", "classic"}, Rating: 5},
+		{ID: "b", Title: "Dune", Tags: []string{"sci-fi", "hardcover"}, Rating: 4},
+		{ID: "c", Title: "Dune", Tags: []string{"Classic", "mass-market"}, Rating: 3},
+	}
+	ix := New(docs)
+
+	tests := []struct {
+		name   string
+		offset int
+		limit  int
+		want   []string
+	}{
+		{"first page", 0, 2, []string{"a", "b"}},
+		{"last page", 2, 2, []string{"c"}},
+		{"past the end", 3, 2, []string{}},
+		{"no limit", 1, 0, []string{"b", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ix.Search(Query{Text: "dune", Offset: tt.offset, Limit: tt.limit})
+			if got := hitIDs(result); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("hits = %v, want %v", got, tt.want)
+			}
+			if result.Total != 3 {
+				t.Errorf("total = %d, want 3", result.Total)
+			}
+			// Фасеты считаются по всем найденным документам, а не по странице
+			wantFacets := []Facet{{Tag: "classic", Count: 2}, {Tag: "sci-fi", Count: 2}, {Tag: "hardcover", Count: 1}, {Tag: "mass-market", Count: 1}}
+			if !reflect.DeepEqual(result.Facets, wantFacets) {
+				t.Errorf("facets = %v, want %v", result.Facets, wantFacets)
+			}
+		})
+	}
+}
+
+func TestBM25(t *testing.T) {
+	ix := New([]Document{
+		{ID: "a", Title: "dune"},
+		{ID: "b", Title: "dune dune"},
+	})
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		// idf = ln(1 + (N - df + 0.5) / (df + 0.5))
+		{"idf of a term in every document", ix.idf(2), math.Log(1 + 0.5/2.5)},
+		{"idf of a term in one document", ix.idf(1), math.Log(1 + 1.5/1.5)},
+		// При средней длине документа нормализация равна 1 и tf насыщается как tf*(k1+1)/(tf+k1)
+		{"saturation at average length", ix.saturate(3, ix.avgDocLen), 3 * (k1 + 1) / (3 + k1)},
+		{"longer document scores lower", ix.saturate(3, 2*ix.avgDocLen), 3 * (k1 + 1) / (3 + k1*(1-b+2*b))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.want) > 1e-9 {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package index
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"Dune", []string{"dune"}},
+		{"Sci-Fi, 2nd edition!", []string{"sci", "fi", "2nd", "edition"}},
+		{"Война и мир", []string{"война", "и", "мир"}},
+		{" -- ", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		max  int
+		want int
+	}{
+		{"dune", "dune", 1, 0},
+		{"dune", "dnue", 1, 1},
+		{"dune", "dunes", 1, 1},
+		{"dune", "dume", 1, 1},
+		{"dune", "une", 1, 1},
+		{"dune", "dnuse", 2, 2},
+		{"hyperion", "hyperoin", 2, 1},
+		// Расстояние больше max не считается до конца
+		{"dune", "emma", 1, 2},
+		{"dune", "dunesand", 2, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := editDistance([]rune(tt.a), []rune(tt.b), tt.max); got != tt.want {
+				t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.max, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package indexer
+
+import (
+	"context"
+	catalogpb "github.com/neokofg/go-pet-microservices/catalog-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/search-service/internal/index"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"time"
+)
+
+const pageSize = 500
+
+var (
+	indexedDocuments = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "search_indexed_documents",
+			Help: "Number of documents in the current search index",
+		},
+	)
+
+	reindexDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "search_reindex_duration_seconds",
+			Help:    "Duration of a full reindex from the catalog service",
+			Buckets: prometheus.DefBuckets,
+		},
+	)
+
+	reindexErrorsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "search_reindex_errors_total",
+			Help: "Total number of failed reindex attempts",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(indexedDocuments)
+	prometheus.MustRegister(reindexDuration)
+	prometheus.MustRegister(reindexErrorsTotal)
+}
+
+// Indexer периодически перечитывает весь каталог и подменяет индекс новой версией
+type Indexer struct {
+	catalog  catalogpb.CatalogServiceClient
+	holder   *index.Holder
+	interval time.Duration
+	logger   *zap.Logger
+}
+
+func New(
+	catalog catalogpb.CatalogServiceClient,
+	holder *index.Holder,
+	interval time.Duration,
+	logger *zap.Logger,
+) *Indexer {
+	return &Indexer{
+		catalog:  catalog,
+		holder:   holder,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run выполняет переиндексацию сразу и затем каждые interval, пока не будет отменён ctx
+func (i *Indexer) Run(ctx context.Context) {
+	ticker := time.NewTicker(i.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := i.Reindex(ctx); err != nil && ctx.Err() == nil {
+			reindexErrorsTotal.Inc()
+			i.logger.Error("Failed to reindex catalog", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (i *Indexer) Reindex(ctx context.Context) error {
+	start := time.Now()
+
+	var docs []index.Document
+	cursor := ""
+	for {
+		resp, err := i.fetchPage(ctx, cursor)
+		if err != nil {
+			return err
+		}
+		for _, itm := range resp.Items {
+			docs = append(docs, index.Document{
+				ID:          itm.Id,
+				Title:       itm.Title,
+				Description: itm.Description,
+				Tags:        itm.Tags,
+				ImageURL:    itm.ImageUrl,
+				Rating:      itm.Rating,
+				ReviewCount: int(itm.ReviewCount),
+			})
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+	}
+
+	i.holder.Store(index.New(docs))
+
+	indexedDocuments.Set(float64(len(docs)))
+	reindexDuration.Observe(time.Since(start).Seconds())
+	i.logger.Info("Catalog reindexed",
+		zap.Int("documents", len(docs)),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return nil
+}
+
+func (i *Indexer) fetchPage(ctx context.Context, cursor string) (*catalogpb.GetItemsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return i.catalog.GetItems(ctx, &catalogpb.GetItemsRequest{
+		Page:      1,
+		Limit:     pageSize,
+		Cursor:    cursor,
+		SkipTotal: true,
+	})
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"github.com/neokofg/go-pet-microservices/search-service/api/proto"
+	"github.com/neokofg/go-pet-microservices/search-service/internal/index"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SearchService struct {
+	proto.UnimplementedSearchServiceServer
+	holder *index.Holder
+	logger *zap.Logger
+}
+
+func NewSearchService(holder *index.Holder, logger *zap.Logger) *SearchService {
+	return &SearchService{
+		holder: holder,
+		logger: logger,
+	}
+}
+
+func (s *SearchService) Search(ctx context.Context, req *proto.SearchRequest) (*proto.SearchResponse, error) {
+	if len(index.Tokenize(req.Query)) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "search query is empty")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+
+	result := s.holder.Load().Search(index.Query{
+		Text:   req.Query,
+		Tags:   req.Tags,
+		Exact:  req.Exact,
+		Offset: (page - 1) * limit,
+		Limit:  limit,
+	})
+
+	hits := make([]*proto.SearchHit, len(result.Hits))
+	for i, hit := range result.Hits {
+		hits[i] = &proto.SearchHit{
+			Id:          hit.Document.ID,
+			Title:       hit.Document.Title,
+			Description: hit.Document.Description,
+			Tags:        hit.Document.Tags,
+			ImageUrl:    hit.Document.ImageURL,
+			Rating:      hit.Document.Rating,
+			ReviewCount: int32(hit.Document.ReviewCount),
+			Score:       hit.Score,
+		}
+	}
+
+	facets := make([]*proto.TagFacet, len(result.Facets))
+	for i, facet := range result.Facets {
+		facets[i] = &proto.TagFacet{
+			Tag:   facet.Tag,
+			Count: int32(facet.Count),
+		}
+	}
+
+	return &proto.SearchResponse{
+		Hits:       hits,
+		Total:      int32(result.Total),
+		Page:       int32(page),
+		TotalPages: int32((result.Total + limit - 1) / limit),
+		Facets:     facets,
+	}, nil
+}